@@ -111,6 +111,7 @@ val services = mapOf(
     "glue" to ServiceSpec("Glue"),
     "grafana" to ServiceSpec("Managed Grafana"),
     "greengrass" to ServiceSpec("IoT Greengrass"),
+    "greengrassv2" to ServiceSpec("IoT Greengrass V2"),
     "groundstation" to ServiceSpec("Ground Station"),
     "guardduty" to ServiceSpec("GuardDuty"),
     "healthlake" to ServiceSpec("HealthLake"),
@@ -122,7 +123,12 @@ val services = mapOf(
     "internetmonitor" to ServiceSpec("CloudWatch Internet Monitor"),
     "iot" to ServiceSpec("IoT Core"),
     "iotanalytics" to ServiceSpec("IoT Analytics"),
+    "iotdeviceadvisor" to ServiceSpec("IoT Device Advisor"),
     "iotevents" to ServiceSpec("IoT Events"),
+    "iotfleetwise" to ServiceSpec("IoT FleetWise"),
+    "iotsitewise" to ServiceSpec("IoT SiteWise"),
+    "iottwinmaker" to ServiceSpec("IoT TwinMaker"),
+    "iotwireless" to ServiceSpec("IoT Wireless"),
     "ipam" to ServiceSpec("VPC IPAM (IP Address Manager)", vpcLock = true, patternOverride = "TestAccIPAM", splitPackageRealPackage = "ec2"),
     "ivs" to ServiceSpec("IVS (Interactive Video)"),
     "ivschat" to ServiceSpec("IVS (Interactive Video) Chat"),
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// chainedAssumeRoleCredentialsProvider returns an AWS SDK for Go v2 credentials provider for the last IAM Role in a chain.
+// Each IAM Role is assumed using the credentials of the previous one, starting with the credentials in cfg.
+func chainedAssumeRoleCredentialsProvider(ctx context.Context, cfg aws_sdkv2.Config, assumeRoles []*awsbase.AssumeRole, stsEndpoint, stsRegion string) (aws_sdkv2.CredentialsProvider, error) {
+	credentials := cfg.Credentials
+
+	for _, ar := range assumeRoles {
+		if ar.RoleARN == "" {
+			return nil, errors.New("assuming chained IAM Role: IAM Role ARN not set")
+		}
+
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		cfg := cfg.Copy()
+		cfg.Credentials = credentials
+		client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+			if stsEndpoint != "" {
+				o.BaseEndpoint = aws_sdkv2.String(stsEndpoint)
+			}
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+		})
+
+		credentials = aws_sdkv2.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.Duration = ar.Duration
+			o.RoleSessionName = ar.SessionName
+			o.TransitiveTagKeys = ar.TransitiveTagKeys
+
+			if ar.ExternalID != "" {
+				o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+			}
+
+			if ar.Policy != "" {
+				o.Policy = aws_sdkv2.String(ar.Policy)
+			}
+
+			for _, v := range ar.PolicyARNs {
+				o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{Arn: aws_sdkv2.String(v)})
+			}
+
+			if ar.SourceIdentity != "" {
+				o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+			}
+
+			for k, v := range ar.Tags {
+				o.Tags = append(o.Tags, ststypes.Tag{Key: aws_sdkv2.String(k), Value: aws_sdkv2.String(v)})
+			}
+		}))
+
+		// Fail fast, the next IAM Role in the chain can't be assumed without these credentials.
+		if _, err := credentials.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("assuming chained IAM Role (%s): %w", ar.RoleARN, err)
+		}
+	}
+
+	return credentials, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+)
+
+func TestChainedAssumeRoleCredentialsProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+		servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+			"ExternalId":     servicemocks.MockStsAssumeRoleExternalId,
+			"SourceIdentity": servicemocks.MockStsAssumeRoleSourceIdentity,
+		}),
+	})
+	defer ts.Close()
+
+	cfg := aws_sdkv2.Config{
+		Credentials: credentials.NewStaticCredentialsProvider(servicemocks.MockStaticAccessKey, servicemocks.MockStaticSecretKey, ""),
+		Region:      "us-east-1", //lintignore:AWSAT003
+	}
+	assumeRoles := []*awsbase.AssumeRole{
+		{
+			ExternalID:     servicemocks.MockStsAssumeRoleExternalId,
+			RoleARN:        servicemocks.MockStsAssumeRoleArn,
+			SessionName:    servicemocks.MockStsAssumeRoleSessionName,
+			SourceIdentity: servicemocks.MockStsAssumeRoleSourceIdentity,
+		},
+	}
+
+	provider, err := chainedAssumeRoleCredentialsProvider(ctx, cfg, assumeRoles, ts.URL, "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	creds, err := provider.Retrieve(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := creds.AccessKeyID, servicemocks.MockStsAssumeRoleAccessKey; got != expected {
+		t.Errorf("AccessKeyID: got %s, expected %s", got, expected)
+	}
+
+	assumeRoles = append(assumeRoles, &awsbase.AssumeRole{})
+
+	if _, err := chainedAssumeRoleCredentialsProvider(ctx, cfg, assumeRoles, ts.URL, ""); err == nil {
+		t.Error("expected error for missing IAM Role ARN")
+	}
+}
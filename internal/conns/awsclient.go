@@ -11,12 +11,15 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
 	efs_sdkv1 "github.com/aws/aws-sdk-go/service/efs"
@@ -42,14 +45,22 @@ type AWSClient struct {
 	conns                     map[string]any
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
+	endpointURL               string            // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	maxBackoff                time.Duration            // From provider configuration.
+	propagationTimeouts       map[string]time.Duration // From provider configuration.
+	regionalClients           map[string]*AWSClient    // Per-Region copies, see ForRegion.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3UsePathStyle            bool            // From provider configuration.
+	s3USEast1RegionalEndpoint string          // From provider configuration.
+	serviceMaxRetries         map[string]int  // From provider configuration.
+	serviceUseFIPSEndpoint    map[string]bool // From provider configuration.
+	skipTagAPICalls           bool            // From provider configuration.
+	skipTagAPICallsTypes      map[string]bool // From provider configuration.
+	stsRegion                 string          // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -64,6 +75,101 @@ func (c *AWSClient) AwsConfig(context.Context) aws_sdkv2.Config { // nosemgrep:c
 	return c.awsConfig.Copy()
 }
 
+// ValidateRegion returns an error if the specified AWS Region is not in the receiver's partition.
+// Credentials, ARNs and DNS suffixes are partition-specific, so API calls can only be made in Regions in the same partition.
+func (c *AWSClient) ValidateRegion(region string) error {
+	if region == "" || c.Partition == "" {
+		return nil
+	}
+
+	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), region); ok && p.ID() != c.Partition {
+		return fmt.Errorf("region (%s) is in partition (%s), not in the provider's partition (%s)", region, p.ID(), c.Partition)
+	}
+
+	return nil
+}
+
+// ForRegion returns an AWSClient that makes AWS API calls in the specified AWS Region.
+// The Region must be in the receiver's partition (see ValidateRegion).
+// All other configuration, including credentials and endpoint overrides, is shared with the receiver.
+// Per-Region clients are cached so that AWS API clients are only created once per Region.
+func (c *AWSClient) ForRegion(ctx context.Context, region string) *AWSClient {
+	if region == "" || region == c.Region {
+		return c
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if v, ok := c.regionalClients[region]; ok {
+		return v
+	}
+
+	client := &AWSClient{
+		AccountID:                 c.AccountID,
+		DefaultTagsConfig:         c.DefaultTagsConfig,
+		IgnoreTagsConfig:          c.IgnoreTagsConfig,
+		Partition:                 c.Partition,
+		Region:                    region,
+		ServicePackages:           c.ServicePackages,
+		clients:                   make(map[string]any, 0),
+		conns:                     make(map[string]any, 0),
+		dnsSuffix:                 c.dnsSuffix,
+		endpoints:                 c.endpoints,
+		endpointURL:               c.endpointURL,
+		httpClient:                c.httpClient,
+		logger:                    c.logger,
+		maxBackoff:                c.maxBackoff,
+		propagationTimeouts:       c.propagationTimeouts,
+		s3UsePathStyle:            c.s3UsePathStyle,
+		s3USEast1RegionalEndpoint: c.s3USEast1RegionalEndpoint,
+		serviceMaxRetries:         c.serviceMaxRetries,
+		serviceUseFIPSEndpoint:    c.serviceUseFIPSEndpoint,
+		skipTagAPICalls:           c.skipTagAPICalls,
+		skipTagAPICallsTypes:      c.skipTagAPICallsTypes,
+		stsRegion:                 c.stsRegion,
+	}
+	if c.awsConfig != nil {
+		cfg := c.awsConfig.Copy()
+		cfg.Region = region
+		client.awsConfig = &cfg
+	}
+	if c.session != nil {
+		client.session = c.session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+	}
+
+	if c.regionalClients == nil {
+		c.regionalClients = make(map[string]*AWSClient)
+	}
+	c.regionalClients[region] = client
+
+	tflog.Debug(ctx, "Created per-Region AWS client", map[string]any{
+		"tf_aws.region": region,
+	})
+
+	return client
+}
+
+// SkipTagAPICalls returns whether separate tagging API calls are skipped for the specified resource type.
+func (c *AWSClient) SkipTagAPICalls(typeName string) bool {
+	if v, ok := c.skipTagAPICallsTypes[typeName]; ok {
+		return v
+	}
+
+	return c.skipTagAPICalls
+}
+
+// DefaultTagsConfigFromContext returns the default tags configuration that applies to the resource type in the specified Context,
+// i.e. nil if the resource type is excluded from default tagging.
+// The provider's default tags configuration is returned outside of a resource type's handlers.
+func (c *AWSClient) DefaultTagsConfigFromContext(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.DefaultConfig
+	}
+
+	return c.DefaultTagsConfig
+}
+
 // DSConnForRegion returns an AWS SDK For Go v1 DS API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
@@ -285,15 +391,58 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	if v, ok := c.serviceMaxRetries[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = c.awsConfigWithMaxRetries(v)
+		m["session"] = c.sessionWithMaxRetries(v)
+	}
+
+	if v, ok := c.serviceUseFIPSEndpoint[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = awsConfigWithFIPSEndpoint(m["aws_sdkv2_config"].(*aws_sdkv2.Config), v)
+		if sess := m["session"].(*session_sdkv1.Session); sess != nil {
+			m["session"] = sessionWithFIPSEndpoint(sess, v)
+		}
+	}
+
 	return m
 }
 
+// awsConfigWithMaxRetries returns a copy of the AWS SDK for Go v2 configuration whose retryer makes at most maxRetries attempts.
+func (c *AWSClient) awsConfigWithMaxRetries(maxRetries int) *aws_sdkv2.Config {
+	cfg := c.awsConfig.Copy()
+
+	if retryer := c.awsConfig.Retryer; retryer != nil {
+		cfg.Retryer = func() aws_sdkv2.Retryer {
+			return retry_sdkv2.AddWithMaxAttempts(retryer(), maxRetries)
+		}
+	}
+	cfg.RetryMaxAttempts = maxRetries
+
+	return &cfg
+}
+
+// sessionWithMaxRetries returns a copy of the AWS SDK for Go v1 session that retries at most maxRetries times.
+func (c *AWSClient) sessionWithMaxRetries(maxRetries int) *session_sdkv1.Session {
+	cfg := &aws_sdkv1.Config{
+		MaxRetries: aws_sdkv1.Int(maxRetries),
+	}
+
+	if c.maxBackoff > 0 {
+		cfg.Retryer = newV1Retryer(maxRetries, c.maxBackoff)
+	}
+
+	return c.session.Copy(cfg)
+}
+
 func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName string) string {
 	endpoint := c.endpoints[servicePackageName]
 	if endpoint != "" {
 		return endpoint
 	}
 
+	if c.endpointURL != "" {
+		return expandEndpointURL(c.endpointURL, servicePackageName, c.Region)
+	}
+
 	// Only continue if there is an SDK v1 package. SDK v2 supports envvars and config file
 	if names.ClientSDKV1(servicePackageName) {
 		endpoint = aws_sdkv2.ToString(c.awsConfig.BaseEndpoint)
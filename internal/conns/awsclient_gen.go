@@ -209,12 +209,18 @@ import (
 	gamelift_sdkv1 "github.com/aws/aws-sdk-go/service/gamelift"
 	glue_sdkv1 "github.com/aws/aws-sdk-go/service/glue"
 	greengrass_sdkv1 "github.com/aws/aws-sdk-go/service/greengrass"
+	greengrassv2_sdkv1 "github.com/aws/aws-sdk-go/service/greengrassv2"
 	guardduty_sdkv1 "github.com/aws/aws-sdk-go/service/guardduty"
 	imagebuilder_sdkv1 "github.com/aws/aws-sdk-go/service/imagebuilder"
 	inspector_sdkv1 "github.com/aws/aws-sdk-go/service/inspector"
 	iot_sdkv1 "github.com/aws/aws-sdk-go/service/iot"
 	iotanalytics_sdkv1 "github.com/aws/aws-sdk-go/service/iotanalytics"
+	iotdeviceadvisor_sdkv1 "github.com/aws/aws-sdk-go/service/iotdeviceadvisor"
 	iotevents_sdkv1 "github.com/aws/aws-sdk-go/service/iotevents"
+	iotfleetwise_sdkv1 "github.com/aws/aws-sdk-go/service/iotfleetwise"
+	iotsitewise_sdkv1 "github.com/aws/aws-sdk-go/service/iotsitewise"
+	iottwinmaker_sdkv1 "github.com/aws/aws-sdk-go/service/iottwinmaker"
+	iotwireless_sdkv1 "github.com/aws/aws-sdk-go/service/iotwireless"
 	ivs_sdkv1 "github.com/aws/aws-sdk-go/service/ivs"
 	kafkaconnect_sdkv1 "github.com/aws/aws-sdk-go/service/kafkaconnect"
 	kinesisanalytics_sdkv1 "github.com/aws/aws-sdk-go/service/kinesisanalytics"
@@ -714,6 +720,10 @@ func (c *AWSClient) GreengrassConn(ctx context.Context) *greengrass_sdkv1.Greeng
 	return errs.Must(conn[*greengrass_sdkv1.Greengrass](ctx, c, names.Greengrass, make(map[string]any)))
 }
 
+func (c *AWSClient) GreengrassV2Conn(ctx context.Context) *greengrassv2_sdkv1.GreengrassV2 {
+	return errs.Must(conn[*greengrassv2_sdkv1.GreengrassV2](ctx, c, names.GreengrassV2, make(map[string]any)))
+}
+
 func (c *AWSClient) GroundStationClient(ctx context.Context) *groundstation_sdkv2.Client {
 	return errs.Must(client[*groundstation_sdkv2.Client](ctx, c, names.GroundStation, make(map[string]any)))
 }
@@ -766,10 +776,30 @@ func (c *AWSClient) IoTAnalyticsConn(ctx context.Context) *iotanalytics_sdkv1.Io
 	return errs.Must(conn[*iotanalytics_sdkv1.IoTAnalytics](ctx, c, names.IoTAnalytics, make(map[string]any)))
 }
 
+func (c *AWSClient) IoTDeviceAdvisorConn(ctx context.Context) *iotdeviceadvisor_sdkv1.IoTDeviceAdvisor {
+	return errs.Must(conn[*iotdeviceadvisor_sdkv1.IoTDeviceAdvisor](ctx, c, names.IoTDeviceAdvisor, make(map[string]any)))
+}
+
 func (c *AWSClient) IoTEventsConn(ctx context.Context) *iotevents_sdkv1.IoTEvents {
 	return errs.Must(conn[*iotevents_sdkv1.IoTEvents](ctx, c, names.IoTEvents, make(map[string]any)))
 }
 
+func (c *AWSClient) IoTFleetWiseConn(ctx context.Context) *iotfleetwise_sdkv1.IoTFleetWise {
+	return errs.Must(conn[*iotfleetwise_sdkv1.IoTFleetWise](ctx, c, names.IoTFleetWise, make(map[string]any)))
+}
+
+func (c *AWSClient) IoTSiteWiseConn(ctx context.Context) *iotsitewise_sdkv1.IoTSiteWise {
+	return errs.Must(conn[*iotsitewise_sdkv1.IoTSiteWise](ctx, c, names.IoTSiteWise, make(map[string]any)))
+}
+
+func (c *AWSClient) IoTTwinMakerConn(ctx context.Context) *iottwinmaker_sdkv1.IoTTwinMaker {
+	return errs.Must(conn[*iottwinmaker_sdkv1.IoTTwinMaker](ctx, c, names.IoTTwinMaker, make(map[string]any)))
+}
+
+func (c *AWSClient) IoTWirelessConn(ctx context.Context) *iotwireless_sdkv1.IoTWireless {
+	return errs.Must(conn[*iotwireless_sdkv1.IoTWireless](ctx, c, names.IoTWireless, make(map[string]any)))
+}
+
 func (c *AWSClient) KMSClient(ctx context.Context) *kms_sdkv2.Client {
 	return errs.Must(client[*kms_sdkv2.Client](ctx, c, names.KMS, make(map[string]any)))
 }
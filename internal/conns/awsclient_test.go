@@ -6,6 +6,17 @@ package conns
 import (
 	"context"
 	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	client_sdkv1 "github.com/aws/aws-sdk-go/aws/client"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -181,3 +192,248 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientServiceMaxRetries(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	awsConfig := aws_sdkv2.Config{
+		Retryer: func() aws_sdkv2.Retryer {
+			return retry_sdkv2.NewStandard()
+		},
+	}
+	client := &AWSClient{
+		awsConfig:         &awsConfig,
+		maxBackoff:        30 * time.Second,
+		serviceMaxRetries: map[string]int{names.EC2: 50},
+		session:           session_sdkv1.Must(session_sdkv1.NewSession()),
+	}
+
+	config := client.apiClientConfig(ctx, names.EC2)
+
+	if got, expected := config["aws_sdkv2_config"].(*aws_sdkv2.Config).Retryer().MaxAttempts(), 50; got != expected {
+		t.Errorf("AWS SDK for Go v2 MaxAttempts: got %d, expected %d", got, expected)
+	}
+
+	sess := config["session"].(*session_sdkv1.Session)
+	if got, expected := aws_sdkv1.IntValue(sess.Config.MaxRetries), 50; got != expected {
+		t.Errorf("AWS SDK for Go v1 MaxRetries: got %d, expected %d", got, expected)
+	}
+	if retryer, ok := sess.Config.Retryer.(client_sdkv1.DefaultRetryer); !ok {
+		t.Errorf("AWS SDK for Go v1 Retryer: got %T, expected client.DefaultRetryer", sess.Config.Retryer)
+	} else if got, expected := retryer.MaxRetryDelay, 30*time.Second; got != expected {
+		t.Errorf("AWS SDK for Go v1 MaxRetryDelay: got %s, expected %s", got, expected)
+	}
+
+	config = client.apiClientConfig(ctx, names.IAM)
+
+	if got, expected := config["aws_sdkv2_config"].(*aws_sdkv2.Config).Retryer().MaxAttempts(), retry_sdkv2.DefaultMaxAttempts; got != expected {
+		t.Errorf("AWS SDK for Go v2 MaxAttempts: got %d, expected %d", got, expected)
+	}
+}
+
+func TestAWSClientServiceUseFIPSEndpoint(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	awsConfig := aws_sdkv2.Config{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+	client := &AWSClient{
+		awsConfig:              &awsConfig,
+		serviceUseFIPSEndpoint: map[string]bool{names.STS: true},
+		session:                session_sdkv1.Must(session_sdkv1.NewSession()),
+	}
+
+	config := client.apiClientConfig(ctx, names.STS)
+
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+	if got, expected := sts_sdkv2.NewFromConfig(cfg).Options().EndpointOptions.UseFIPSEndpoint, aws_sdkv2.FIPSEndpointStateEnabled; got != expected {
+		t.Errorf("AWS SDK for Go v2 UseFIPSEndpoint: got %v, expected %v", got, expected)
+	}
+
+	sess := config["session"].(*session_sdkv1.Session)
+	if got, expected := sess.Config.UseFIPSEndpoint, endpoints_sdkv1.FIPSEndpointStateEnabled; got != expected {
+		t.Errorf("AWS SDK for Go v1 UseFIPSEndpoint: got %v, expected %v", got, expected)
+	}
+
+	config = client.apiClientConfig(ctx, names.IAM)
+
+	if got, expected := config["aws_sdkv2_config"].(*aws_sdkv2.Config), client.awsConfig; got != expected {
+		t.Errorf("AWS SDK for Go v2 configuration: got %p, expected %p", got, expected)
+	}
+}
+
+func TestAWSClientForRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	awsConfig := aws_sdkv2.Config{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+	client := &AWSClient{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+		awsConfig: &awsConfig,
+		dnsSuffix: "amazonaws.com",
+		session:   session_sdkv1.Must(session_sdkv1.NewSession(&aws_sdkv1.Config{Region: aws_sdkv1.String("us-west-2")})), //lintignore:AWSAT003
+	}
+
+	if got := client.ForRegion(ctx, ""); got != client {
+		t.Errorf("empty Region: got %p, expected %p", got, client)
+	}
+	if got := client.ForRegion(ctx, "us-west-2"); got != client { //lintignore:AWSAT003
+		t.Errorf("provider Region: got %p, expected %p", got, client)
+	}
+
+	regional := client.ForRegion(ctx, "eu-west-1") //lintignore:AWSAT003
+
+	if got, expected := regional.Region, "eu-west-1"; got != expected { //lintignore:AWSAT003
+		t.Errorf("Region: got %s, expected %s", got, expected)
+	}
+	if got, expected := regional.AccountID, client.AccountID; got != expected {
+		t.Errorf("AccountID: got %s, expected %s", got, expected)
+	}
+	if got, expected := regional.AwsConfig(ctx).Region, "eu-west-1"; got != expected { //lintignore:AWSAT003
+		t.Errorf("AWS SDK for Go v2 Region: got %s, expected %s", got, expected)
+	}
+	if got, expected := aws_sdkv1.StringValue(regional.session.Config.Region), "eu-west-1"; got != expected { //lintignore:AWSAT003
+		t.Errorf("AWS SDK for Go v1 Region: got %s, expected %s", got, expected)
+	}
+	if got, expected := regional.RegionalHostname(ctx, "test"), "test.eu-west-1.amazonaws.com"; got != expected { //lintignore:AWSAT003
+		t.Errorf("RegionalHostname: got %s, expected %s", got, expected)
+	}
+	if got, expected := client.AwsConfig(ctx).Region, "us-west-2"; got != expected { //lintignore:AWSAT003
+		t.Errorf("provider AWS SDK for Go v2 Region: got %s, expected %s", got, expected)
+	}
+
+	if got := client.ForRegion(ctx, "eu-west-1"); got != regional { //lintignore:AWSAT003
+		t.Errorf("cached: got %p, expected %p", got, regional)
+	}
+}
+
+func TestAWSClientValidateRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := []struct {
+		region      string
+		expectError bool
+	}{
+		{
+			region: "",
+		},
+		{
+			region: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			region:      "cn-north-1", //lintignore:AWSAT003
+			expectError: true,
+		},
+		{
+			region:      "us-gov-west-1", //lintignore:AWSAT003
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.region, func(t *testing.T) {
+			t.Parallel()
+
+			err := client.ValidateRegion(testCase.region)
+
+			if got, expected := err != nil, testCase.expectError; got != expected {
+				t.Errorf("got error %v, expected error: %t", err, expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientSkipTagAPICalls(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		skipTagAPICalls: true,
+		skipTagAPICallsTypes: map[string]bool{
+			"aws_instance": false,
+		},
+	}
+
+	if !client.SkipTagAPICalls("aws_vpc") {
+		t.Errorf("aws_vpc: got false, expected true")
+	}
+	if client.SkipTagAPICalls("aws_instance") {
+		t.Errorf("aws_instance: got true, expected false")
+	}
+
+	client = &AWSClient{
+		skipTagAPICallsTypes: map[string]bool{
+			"aws_instance": true,
+		},
+	}
+
+	if client.SkipTagAPICalls("aws_vpc") {
+		t.Errorf("aws_vpc: got true, expected false")
+	}
+	if !client.SkipTagAPICalls("aws_instance") {
+		t.Errorf("aws_instance: got false, expected true")
+	}
+}
+
+func TestAWSClientDefaultTagsConfigFromContext(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	defaultTagsConfig := &tftags.DefaultConfig{
+		Tags:     tftags.New(ctx, map[string]interface{}{"key1": "value1"}),
+		Excludes: []string{"aws_autoscaling_group"},
+	}
+	client := &AWSClient{
+		DefaultTagsConfig: defaultTagsConfig,
+	}
+
+	if got := client.DefaultTagsConfigFromContext(ctx); got != defaultTagsConfig {
+		t.Errorf("no tagging context: got %v, expected %v", got, defaultTagsConfig)
+	}
+
+	resourceCtx := tftags.NewContext(ctx, defaultTagsConfig.ForResourceType("aws_instance"), nil, false)
+	if got := client.DefaultTagsConfigFromContext(resourceCtx); got != defaultTagsConfig {
+		t.Errorf("aws_instance: got %v, expected %v", got, defaultTagsConfig)
+	}
+
+	resourceCtx = tftags.NewContext(ctx, defaultTagsConfig.ForResourceType("aws_autoscaling_group"), nil, false)
+	if got := client.DefaultTagsConfigFromContext(resourceCtx); got != nil {
+		t.Errorf("aws_autoscaling_group: got %v, expected nil", got)
+	}
+}
+
+func TestAWSClientPropagationTimeout(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultTimeout := 2 * time.Minute
+
+	if got, expected := PropagationTimeout(ctx, names.IAM, defaultTimeout), defaultTimeout; got != expected {
+		t.Errorf("no configuration: got %s, expected %s", got, expected)
+	}
+
+	client := &AWSClient{
+		propagationTimeouts: map[string]time.Duration{
+			names.IAM: 10 * time.Minute,
+		},
+	}
+	ctx = client.RegisterPropagationTimeouts(ctx)
+
+	if got, expected := PropagationTimeout(ctx, names.IAM, defaultTimeout), 10*time.Minute; got != expected {
+		t.Errorf("%s: got %s, expected %s", names.IAM, got, expected)
+	}
+	if got, expected := PropagationTimeout(ctx, names.KMS, defaultTimeout), defaultTimeout; got != expected {
+		t.Errorf("%s: got %s, expected %s", names.KMS, got, expected)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	client_sdkv1 "github.com/aws/aws-sdk-go/aws/client"
 )
 
 // AWS SDK for Go v1 compatible Backoff.
@@ -48,6 +49,15 @@ func (c *v1CompatibleBackoff) BackoffDelay(attempt int, err error) (time.Duratio
 	return delay, nil
 }
 
+// newV1Retryer returns an AWS SDK for Go v1 retryer whose retry and throttle delays are capped at maxRetryDelay.
+func newV1Retryer(maxRetries int, maxRetryDelay time.Duration) client_sdkv1.DefaultRetryer {
+	return client_sdkv1.DefaultRetryer{
+		NumMaxRetries:    maxRetries,
+		MaxRetryDelay:    maxRetryDelay,
+		MaxThrottleDelay: maxRetryDelay,
+	}
+}
+
 func getJitterDelay(duration time.Duration) time.Duration {
 	return time.Duration(seededRand.Int63n(int64(duration)) + int64(duration))
 }
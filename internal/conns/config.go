@@ -6,11 +6,14 @@ package conns
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
@@ -29,32 +32,40 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EndpointURL                    string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	HTTPAuditLogFile               string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	MaxBackoff                     time.Duration
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	PropagationTimeouts            map[string]time.Duration
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceMaxRetries              map[string]int
+	ServiceUseFIPSEndpoint         map[string]bool
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
+	SkipTagAPICalls                bool
+	SkipTagAPICallsResourceTypes   map[string]bool
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TerraformVersion               string
@@ -62,6 +73,8 @@ type Config struct {
 	TokenBucketRateLimiterCapacity int
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+	UsePathStyle                   bool
+	UserAgent                      awsbase.UserAgentProducts
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -71,8 +84,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, logger := logging.NewTfLogger(ctx)
 
 	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+		defaultMaxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
+	maxBackoff := defaultMaxBackoff
+	if c.MaxBackoff > 0 {
+		maxBackoff = c.MaxBackoff
+	}
+	// The IAM, SSO and STS endpoints are needed before the AWS SDK configuration, and so the Region, is loaded.
+	endpointRegion := c.endpointURLRegion(ctx)
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -89,7 +108,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		CallerName:                     "Terraform AWS Provider",
 		EC2MetadataServiceEnableState:  c.EC2MetadataServiceEnableState,
 		ForbiddenAccountIds:            c.ForbiddenAccountIds,
-		IamEndpoint:                    c.Endpoints[names.IAM],
+		IamEndpoint:                    c.endpoint(names.IAM, endpointRegion),
 		Insecure:                       c.Insecure,
 		HTTPClient:                     client.HTTPClient(ctx),
 		HTTPProxy:                      c.HTTPProxy,
@@ -105,17 +124,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		SecretKey:                      c.SecretKey,
 		SkipCredsValidation:            c.SkipCredsValidation,
 		SkipRequestingAccountId:        c.SkipRequestingAccountId,
-		SsoEndpoint:                    c.Endpoints[names.SSO],
-		StsEndpoint:                    c.Endpoints[names.STS],
+		SsoEndpoint:                    c.endpoint(names.SSO, endpointRegion),
+		StsEndpoint:                    c.endpoint(names.STS, endpointRegion),
 		SuppressDebugLog:               c.SuppressDebugLog,
 		Token:                          c.Token,
 		TokenBucketRateLimiterCapacity: c.TokenBucketRateLimiterCapacity,
 		UseDualStackEndpoint:           c.UseDualStackEndpoint,
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
+		UserAgent:                      c.UserAgent,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0] != nil && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -144,15 +164,22 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
 	awsbaseConfig.SkipCredsValidation = true
 
+	ssoProfile := c.ssoProfile(ctx)
+
 	tflog.Debug(ctx, "Configuring Terraform AWS Provider")
 	ctx, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 
 	for _, d := range awsDiags {
-		diags = append(diags, diag.Diagnostic{
+		diagnostic := diag.Diagnostic{
 			Severity: baseSeverityToSDKSeverity(d.Severity()),
 			Summary:  d.Summary(),
 			Detail:   d.Detail(),
-		})
+		}
+		// Credentials can't be retrieved if the AWS IAM Identity Center access token has expired.
+		if ssoProfile != nil && diagnostic.Severity == diag.Error && isSSOTokenErrorMessage(diagnostic.Summary+"\n"+diagnostic.Detail) {
+			diagnostic.Detail = strings.TrimSpace(diagnostic.Detail + "\n\n" + ssoProfile.loginDetail())
+		}
+		diags = append(diags, diagnostic)
 	}
 
 	if diags.HasError() {
@@ -166,6 +193,39 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	var auditLog *httpAuditLog
+	if c.HTTPAuditLogFile != "" {
+		var err error
+		auditLog, err = newHTTPAuditLog(c.HTTPAuditLogFile)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening HTTP audit log file (%s): %s", c.HTTPAuditLogFile, err)
+		}
+		cfg.HTTPClient = auditLog.httpClient(cfg.HTTPClient)
+	}
+
+	// Any further IAM Roles are assumed in order, each using the credentials of the previous one.
+	if len(c.AssumeRole) > 1 {
+		credentials, err := chainedAssumeRoleCredentialsProvider(ctx, cfg, c.AssumeRole[1:], c.endpoint(names.STS, c.Region), c.STSRegion)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		cfg.Credentials = credentials
+	}
+
+	// Report how to sign in again if the AWS IAM Identity Center access token expires and can't be refreshed during a run.
+	// The configured credentials provider already caches credentials, so it is wrapped as-is.
+	if ssoProfile != nil {
+		cfg.Credentials = &ssoCredentialsProvider{
+			provider: cfg.Credentials,
+			profile:  ssoProfile,
+		}
+	}
+
+	// Make all requests to the configured endpoints, e.g. when using a local AWS emulator.
+	if c.UsePathStyle {
+		cfg.APIOptions = append(cfg.APIOptions, disableEndpointHostPrefix)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -183,6 +243,28 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// The AWS SDK for Go v1 default retryer has a fixed maximum delay, so only replace it when the maximum backoff is configured.
+	if c.MaxBackoff > 0 {
+		session = session.Copy(&aws_sdkv1.Config{
+			Retryer: newV1Retryer(aws_sdkv1.IntValue(session.Config.MaxRetries), maxBackoff),
+		})
+	}
+
+	if auditLog != nil {
+		httpClient := *session.Config.HTTPClient
+		httpClient.Transport = auditLog.transport(httpClient.Transport)
+		session = session.Copy(&aws_sdkv1.Config{
+			HTTPClient: &httpClient,
+		})
+	}
+
+	if c.UsePathStyle {
+		session = session.Copy(&aws_sdkv1.Config{
+			DisableEndpointHostPrefix: aws_sdkv1.Bool(true),
+			S3ForcePathStyle:          aws_sdkv1.Bool(true),
+		})
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -223,14 +305,45 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.endpointURL = c.EndpointURL
 	client.logger = logger
-	client.s3UsePathStyle = c.S3UsePathStyle
+	client.maxBackoff = c.MaxBackoff
+	client.propagationTimeouts = c.PropagationTimeouts
+	client.s3UsePathStyle = c.S3UsePathStyle || c.UsePathStyle
+	client.serviceMaxRetries = c.ServiceMaxRetries
+	client.serviceUseFIPSEndpoint = c.ServiceUseFIPSEndpoint
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.skipTagAPICalls = c.SkipTagAPICalls
+	client.skipTagAPICallsTypes = c.SkipTagAPICallsResourceTypes
 	client.stsRegion = c.STSRegion
 
 	return client, diags
 }
 
+// sharedConfigProfile loads the configured, or default, shared configuration profile.
+func (c *Config) sharedConfigProfile(ctx context.Context) (config_sdkv2.SharedConfig, error) {
+	name := c.Profile
+	if name == "" {
+		name = os.Getenv("AWS_PROFILE")
+	}
+	if name == "" {
+		name = "default"
+	}
+
+	return config_sdkv2.LoadSharedConfigProfile(ctx, name, func(o *config_sdkv2.LoadSharedConfigOptions) {
+		if len(c.SharedConfigFiles) > 0 {
+			o.ConfigFiles = c.SharedConfigFiles
+		} else if v := os.Getenv("AWS_CONFIG_FILE"); v != "" {
+			o.ConfigFiles = []string{v}
+		}
+		if len(c.SharedCredentialsFiles) > 0 {
+			o.CredentialsFiles = c.SharedCredentialsFiles
+		} else if v := os.Getenv("AWS_SHARED_CREDENTIALS_FILE"); v != "" {
+			o.CredentialsFiles = []string{v}
+		}
+	})
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const (
+	endpointURLServicePlaceholder = "{service}"
+	endpointURLRegionPlaceholder  = "{region}"
+)
+
+// expandEndpointURL returns the endpoint URL for the specified service from the provider-level endpoint URL template.
+// The `{service}` placeholder is replaced by the service package name, i.e. the service's key in the `endpoints` configuration block
+// (not an alias), which isn't always the prefix of the service's AWS endpoint hostname. The `{region}` placeholder is replaced by the Region.
+func expandEndpointURL(template, servicePackageName, region string) string {
+	return strings.NewReplacer(
+		endpointURLServicePlaceholder, servicePackageName,
+		endpointURLRegionPlaceholder, region,
+	).Replace(template)
+}
+
+// endpoint returns the configured endpoint URL for the specified service in the specified Region.
+// A per-service endpoint takes precedence over the provider-level endpoint URL template.
+func (c *Config) endpoint(servicePackageName, region string) string {
+	if v := c.Endpoints[servicePackageName]; v != "" {
+		return v
+	}
+
+	if c.EndpointURL != "" {
+		return expandEndpointURL(c.EndpointURL, servicePackageName, region)
+	}
+
+	return ""
+}
+
+// endpointURLRegion returns the Region used to expand the endpoint URL template before the AWS SDK configuration is loaded.
+// As with the AWS SDK, the Region is resolved from the provider configuration, then the environment and then the shared configuration profile.
+func (c *Config) endpointURLRegion(ctx context.Context) string {
+	if c.Region != "" || !strings.Contains(c.EndpointURL, endpointURLRegionPlaceholder) {
+		return c.Region
+	}
+
+	for _, k := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+		if v := os.Getenv(k); v != "" {
+			return v
+		}
+	}
+
+	if sharedConfig, err := c.sharedConfigProfile(ctx); err == nil {
+		return sharedConfig.Region
+	}
+
+	return ""
+}
+
+// disableEndpointHostPrefix is an AWS SDK for Go v2 API option that stops operations prefixing the endpoint's hostname,
+// e.g. `data-servicediscovery.` or `<account-id>.`, so that all requests are made to the configured endpoint.
+func disableEndpointHostPrefix(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("DisableEndpointHostPrefix", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		return next.HandleInitialize(smithyhttp.DisableEndpointHostPrefix(ctx, true), in)
+	}), middleware.Before)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandEndpointURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		template string
+		expected string
+	}{
+		{
+			template: "http://localhost:4566",
+			expected: "http://localhost:4566",
+		},
+		{
+			template: "http://{service}.localhost.localstack.cloud:4566",
+			expected: "http://sqs.localhost.localstack.cloud:4566",
+		},
+		{
+			template: "https://{service}.{region}.example.com/{service}",
+			expected: "https://sqs.eu-west-1.example.com/sqs", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.template, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := expandEndpointURL(testCase.template, names.SQS, "eu-west-1"), testCase.expected; got != expected { //lintignore:AWSAT003
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientResolveEndpointURL(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	client := &AWSClient{
+		Region:    "us-west-2", //lintignore:AWSAT003
+		awsConfig: &aws_sdkv2.Config{},
+		endpoints: map[string]string{
+			names.SQS: "http://sqs.example.com",
+		},
+		endpointURL: "http://localhost:4566/{region}",
+	}
+
+	if got, expected := client.resolveEndpoint(ctx, names.SQS), "http://sqs.example.com"; got != expected {
+		t.Errorf("%s: got %s, expected %s", names.SQS, got, expected)
+	}
+	if got, expected := client.resolveEndpoint(ctx, names.SNS), "http://localhost:4566/us-west-2"; got != expected { //lintignore:AWSAT003
+		t.Errorf("%s: got %s, expected %s", names.SNS, got, expected)
+	}
+
+	config := &Config{
+		EndpointURL: "http://localhost:4566",
+		Endpoints: map[string]string{
+			names.IAM: "http://iam.example.com",
+		},
+	}
+
+	if got, expected := config.endpoint(names.IAM, "us-west-2"), "http://iam.example.com"; got != expected { //lintignore:AWSAT003
+		t.Errorf("%s: got %s, expected %s", names.IAM, got, expected)
+	}
+	if got, expected := config.endpoint(names.STS, "us-west-2"), "http://localhost:4566"; got != expected { //lintignore:AWSAT003
+		t.Errorf("%s: got %s, expected %s", names.STS, got, expected)
+	}
+}
+
+func TestConfigEndpointURLRegion(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	ctx := context.TODO()
+
+	sharedConfigFile := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(sharedConfigFile, []byte("[profile example]\nregion = eu-west-1\n"), 0600); err != nil { //lintignore:AWSAT003
+		t.Fatal(err)
+	}
+
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "")
+
+	config := &Config{
+		EndpointURL:       "http://{service}.{region}.localhost:4566",
+		Profile:           "example",
+		SharedConfigFiles: []string{sharedConfigFile},
+	}
+
+	if got, expected := config.endpoint(names.STS, config.endpointURLRegion(ctx)), "http://sts.eu-west-1.localhost:4566"; got != expected { //lintignore:AWSAT003
+		t.Errorf("shared configuration: got %s, expected %s", got, expected)
+	}
+
+	t.Setenv("AWS_DEFAULT_REGION", "us-east-2") //lintignore:AWSAT003
+
+	if got, expected := config.endpoint(names.STS, config.endpointURLRegion(ctx)), "http://sts.us-east-2.localhost:4566"; got != expected { //lintignore:AWSAT003
+		t.Errorf("environment: got %s, expected %s", got, expected)
+	}
+
+	config.Region = "us-west-2" //lintignore:AWSAT003
+
+	if got, expected := config.endpoint(names.STS, config.endpointURLRegion(ctx)), "http://sts.us-west-2.localhost:4566"; got != expected { //lintignore:AWSAT003
+		t.Errorf("provider configuration: got %s, expected %s", got, expected)
+	}
+}
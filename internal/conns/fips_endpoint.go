@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+)
+
+// fipsEndpointSource is an AWS SDK for Go v2 configuration source that overrides the UseFIPSEndpoint setting.
+// AWS API clients use the first configuration source that specifies a value.
+type fipsEndpointSource aws_sdkv2.FIPSEndpointState
+
+func (s fipsEndpointSource) GetUseFIPSEndpoint(context.Context) (aws_sdkv2.FIPSEndpointState, bool, error) {
+	return aws_sdkv2.FIPSEndpointState(s), true, nil
+}
+
+// awsConfigWithFIPSEndpoint returns a copy of the specified AWS SDK for Go v2 configuration that does or does not use FIPS endpoints.
+func awsConfigWithFIPSEndpoint(awsConfig *aws_sdkv2.Config, useFIPSEndpoint bool) *aws_sdkv2.Config {
+	state := aws_sdkv2.FIPSEndpointStateDisabled
+	if useFIPSEndpoint {
+		state = aws_sdkv2.FIPSEndpointStateEnabled
+	}
+
+	cfg := awsConfig.Copy()
+	cfg.ConfigSources = append([]any{fipsEndpointSource(state)}, awsConfig.ConfigSources...)
+
+	return &cfg
+}
+
+// sessionWithFIPSEndpoint returns a copy of the specified AWS SDK for Go v1 session that does or does not use FIPS endpoints.
+func sessionWithFIPSEndpoint(sess *session_sdkv1.Session, useFIPSEndpoint bool) *session_sdkv1.Session {
+	state := endpoints_sdkv1.FIPSEndpointStateDisabled
+	if useFIPSEndpoint {
+		state = endpoints_sdkv1.FIPSEndpointStateEnabled
+	}
+
+	return sess.Copy(&aws_sdkv1.Config{UseFIPSEndpoint: state})
+}
@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
+)
+
+const (
+	// httpAuditLogMaxBodySize is the maximum number of bytes of each request or response body that is recorded.
+	httpAuditLogMaxBodySize = 64 * 1024
+	httpAuditLogRedacted    = "[REDACTED]"
+)
+
+var (
+	// httpAuditLogSensitiveHeaders are the (canonical) names of HTTP headers whose values are never recorded.
+	httpAuditLogSensitiveHeaders = []string{
+		"Authorization",
+		"Cookie",
+		"Set-Cookie",
+		"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+		"X-Amz-Security-Token",
+		"X-Amz-Server-Side-Encryption-Customer-Key",
+	}
+
+	// httpAuditLogSensitiveKeyFragments are matched against lower-cased JSON keys, query parameter names and XML element names.
+	// Matching values are never recorded.
+	httpAuditLogSensitiveKeyFragments = []string{
+		"accesstoken",
+		"authtoken",
+		"credential",
+		"passphrase",
+		"password",
+		"plaintext",
+		"privatekey",
+		"refreshtoken",
+		"secret",
+		"securitytoken",
+		"sessiontoken",
+		"signature",
+	}
+
+	// httpAuditLogSensitiveOperations are the names of operations whose request and response bodies carry secret material
+	// in members that can't be identified by name alone, e.g. the `Value` of an SSM parameter. Their bodies are never recorded.
+	httpAuditLogSensitiveOperations = []string{
+		// ACM.
+		"ExportCertificate",
+		"ImportCertificate",
+		// IAM.
+		"ChangePassword",
+		"CreateAccessKey",
+		"CreateLoginProfile",
+		"CreateServiceSpecificCredential",
+		"ResetServiceSpecificCredential",
+		"UpdateLoginProfile",
+		"UploadServerCertificate",
+		// KMS.
+		"Decrypt",
+		"Encrypt",
+		"GenerateDataKey",
+		"GenerateDataKeyPair",
+		"GenerateRandom",
+		"ReEncrypt",
+		// Secrets Manager.
+		"BatchGetSecretValue",
+		"CreateSecret",
+		"GetRandomPassword",
+		"GetSecretValue",
+		"PutSecretValue",
+		"UpdateSecret",
+		// SSM.
+		"GetParameter",
+		"GetParameterHistory",
+		"GetParameters",
+		"GetParametersByPath",
+		"PutParameter",
+		// STS and IAM Identity Center.
+		"AssumeRole",
+		"AssumeRoleWithSAML",
+		"AssumeRoleWithWebIdentity",
+		"CreateToken",
+		"GetFederationToken",
+		"GetRoleCredentials",
+		"GetSessionToken",
+	}
+
+	httpAuditLogJSONMemberRegex = regexp.MustCompile(`"([^"\\]+)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	httpAuditLogXMLElementRegex = regexp.MustCompile(`<([A-Za-z0-9_.:-]+)>([^<]*)</`)
+)
+
+// httpAuditLogRecord is a single line in the HTTP audit log.
+type httpAuditLogRecord struct {
+	Time            time.Time           `json:"time"`
+	DurationMS      int64               `json:"duration_ms"`
+	Service         string              `json:"service,omitempty"`
+	Operation       string              `json:"operation,omitempty"`
+	Method          string              `json:"method"`
+	URL             string              `json:"url"`
+	RequestHeaders  map[string][]string `json:"request_headers,omitempty"`
+	RequestBody     string              `json:"request_body,omitempty"`
+	StatusCode      int                 `json:"status_code,omitempty"`
+	ResponseHeaders map[string][]string `json:"response_headers,omitempty"`
+	ResponseBody    string              `json:"response_body,omitempty"`
+	Error           string              `json:"error,omitempty"`
+}
+
+// httpAuditLog writes AWS API requests and responses, with credentials and secret material redacted, to a JSON Lines file.
+type httpAuditLog struct {
+	lock   sync.Mutex
+	writer io.Writer
+}
+
+var (
+	httpAuditLogsLock sync.Mutex
+	httpAuditLogs     = make(map[string]*httpAuditLog)
+)
+
+// newHTTPAuditLog returns the audit log writing to the specified file.
+// The provider may be configured many times during a run, so each file is opened once and shared.
+// It remains open until the provider process exits as AWS clients may be used until then.
+func newHTTPAuditLog(path string) (*httpAuditLog, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	httpAuditLogsLock.Lock()
+	defer httpAuditLogsLock.Unlock()
+
+	if l, ok := httpAuditLogs[path]; ok {
+		return l, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &httpAuditLog{writer: f}
+	httpAuditLogs[path] = l
+
+	return l, nil
+}
+
+// roundTrip makes an HTTP request using the specified function and records the request and response.
+func (l *httpAuditLog) roundTrip(req *http.Request, f func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	ctx := req.Context()
+	record := httpAuditLogRecord{
+		Time:           time.Now().UTC(),
+		Service:        awsmiddleware.GetServiceID(ctx),
+		Method:         req.Method,
+		URL:            redactURL(req.URL),
+		RequestHeaders: redactHeaders(req.Header),
+	}
+
+	var requestBody []byte
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			requestBody, _ = io.ReadAll(io.LimitReader(body, httpAuditLogMaxBodySize))
+			body.Close()
+		}
+	} else if req.Body != nil && req.Body != http.NoBody {
+		// The request body can't be re-read, so send the recorded prefix followed by the remainder.
+		requestBody, _ = io.ReadAll(io.LimitReader(req.Body, httpAuditLogMaxBodySize))
+		body := req.Body
+		req = req.Clone(ctx)
+		req.Body = prefixedReadCloser(requestBody, body)
+	}
+
+	record.Operation = httpAuditLogOperation(req, requestBody)
+	sensitiveOperation := slices.Contains(httpAuditLogSensitiveOperations, record.Operation)
+
+	if len(requestBody) > 0 {
+		if sensitiveOperation {
+			record.RequestBody = httpAuditLogRedacted
+		} else {
+			record.RequestBody = redactBody(requestBody, req.Header.Get("Content-Type"))
+		}
+	}
+
+	resp, err := f(req)
+
+	record.DurationMS = time.Since(record.Time).Milliseconds()
+
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if resp != nil {
+		record.StatusCode = resp.StatusCode
+		record.ResponseHeaders = redactHeaders(resp.Header)
+
+		if resp.Body != nil {
+			// Only the recorded prefix of the body is buffered, the remainder is streamed to the caller.
+			b, _ := io.ReadAll(io.LimitReader(resp.Body, httpAuditLogMaxBodySize))
+			resp.Body = prefixedReadCloser(b, resp.Body)
+			if sensitiveOperation && len(b) > 0 {
+				record.ResponseBody = httpAuditLogRedacted
+			} else {
+				record.ResponseBody = redactBody(b, resp.Header.Get("Content-Type"))
+			}
+		}
+	}
+
+	l.write(&record)
+
+	return resp, err
+}
+
+// httpAuditLogOperation returns the name of the API operation made by the specified request.
+// AWS SDK for Go v1 requests don't carry the operation name in their context, so it's taken from the JSON protocol
+// target header or the query protocol action instead.
+func httpAuditLogOperation(req *http.Request, body []byte) string {
+	if v := awsmiddleware.GetOperationName(req.Context()); v != "" {
+		return v
+	}
+
+	if v := req.Header.Get("X-Amz-Target"); v != "" {
+		return v[strings.LastIndexByte(v, '.')+1:]
+	}
+
+	if v := req.URL.Query().Get("Action"); v != "" {
+		return v
+	}
+
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return values.Get("Action")
+		}
+	}
+
+	return ""
+}
+
+// prefixedReadCloser returns an io.ReadCloser that reads the already consumed prefix and then the remainder of body.
+func prefixedReadCloser(prefix []byte, body io.ReadCloser) io.ReadCloser {
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), body), body}
+}
+
+func (l *httpAuditLog) write(record *httpAuditLogRecord) {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	b = append(b, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	_, _ = l.writer.Write(b)
+}
+
+// httpClient returns an AWS SDK for Go v2 HTTP client that records all requests made by the specified client.
+func (l *httpAuditLog) httpClient(client aws_sdkv2.HTTPClient) aws_sdkv2.HTTPClient {
+	return &httpAuditLogClient{client: client, log: l}
+}
+
+// transport returns an HTTP transport that records all requests made by the specified transport.
+func (l *httpAuditLog) transport(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &httpAuditLogTransport{transport: transport, log: l}
+}
+
+type httpAuditLogClient struct {
+	client aws_sdkv2.HTTPClient
+	log    *httpAuditLog
+}
+
+func (c *httpAuditLogClient) Do(req *http.Request) (*http.Response, error) {
+	return c.log.roundTrip(req, c.client.Do)
+}
+
+type httpAuditLogTransport struct {
+	transport http.RoundTripper
+	log       *httpAuditLog
+}
+
+func (t *httpAuditLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.log.roundTrip(req, t.transport.RoundTrip)
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+
+	// Identifiers of secrets, e.g. `SecretId` or `CredentialsArn`, aren't themselves secret.
+	for _, v := range []string{"arn", "arns", "id", "ids", "name", "names"} {
+		if strings.HasSuffix(key, v) {
+			return false
+		}
+	}
+
+	for _, v := range httpAuditLogSensitiveKeyFragments {
+		if strings.Contains(key, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	v := *u
+	v.User = nil
+	if query := v.Query(); len(query) > 0 {
+		for k := range query {
+			if isSensitiveKey(k) {
+				query.Set(k, httpAuditLogRedacted)
+			}
+		}
+		v.RawQuery = query.Encode()
+	}
+
+	return v.String()
+}
+
+func redactHeaders(header http.Header) map[string][]string {
+	if len(header) == 0 {
+		return nil
+	}
+
+	m := make(map[string][]string, len(header))
+	for k, v := range header {
+		if k := http.CanonicalHeaderKey(k); slices.Contains(httpAuditLogSensitiveHeaders, k) || isSensitiveKey(k) {
+			m[k] = []string{httpAuditLogRedacted}
+		} else {
+			m[k] = v
+		}
+	}
+
+	return m
+}
+
+// redactBody returns the body with the values of sensitive JSON keys, form parameters and XML elements redacted.
+// Any remaining AWS access keys and likely secret access keys are masked.
+func redactBody(b []byte, contentType string) string {
+	if len(b) == 0 {
+		return ""
+	}
+
+	switch {
+	case strings.Contains(contentType, "json"):
+		var v any
+		if err := json.Unmarshal(b, &v); err == nil {
+			if redacted, err := json.Marshal(redactJSON(v)); err == nil {
+				b = redacted
+			}
+		} else {
+			// Truncated or otherwise invalid JSON, redact any complete string members.
+			b = httpAuditLogJSONMemberRegex.ReplaceAllFunc(b, func(match []byte) []byte {
+				if submatches := httpAuditLogJSONMemberRegex.FindSubmatch(match); isSensitiveKey(string(submatches[1])) {
+					return []byte(`"` + string(submatches[1]) + `"` + string(submatches[2]) + `"` + httpAuditLogRedacted + `"`)
+				}
+				return match
+			})
+		}
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		if values, err := url.ParseQuery(string(b)); err == nil {
+			for k := range values {
+				if isSensitiveKey(k) {
+					values.Set(k, httpAuditLogRedacted)
+				}
+			}
+			b = []byte(values.Encode())
+		}
+	case strings.Contains(contentType, "xml"):
+		b = httpAuditLogXMLElementRegex.ReplaceAllFunc(b, func(match []byte) []byte {
+			if submatches := httpAuditLogXMLElementRegex.FindSubmatch(match); isSensitiveKey(string(submatches[1])) {
+				return []byte("<" + string(submatches[1]) + ">" + httpAuditLogRedacted + "</")
+			}
+			return match
+		})
+	}
+
+	b = bytes.Clone(b)
+	b = baselogging.MaskAWSAccessKey(b)
+	baselogging.MaskAWSSecretKeys(b)
+
+	return string(b)
+}
+
+func redactJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isSensitiveKey(k) {
+				v[k] = httpAuditLogRedacted
+			} else {
+				v[k] = redactJSON(e)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = redactJSON(e)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"time"
+)
+
+type propagationTimeoutsKeyType int
+
+var propagationTimeoutsKey propagationTimeoutsKeyType
+
+// RegisterPropagationTimeouts returns a Context that holds any provider configured eventual consistency propagation timeouts.
+func (c *AWSClient) RegisterPropagationTimeouts(ctx context.Context) context.Context {
+	if len(c.propagationTimeouts) == 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, propagationTimeoutsKey, c.propagationTimeouts)
+}
+
+// PropagationTimeout returns how long to wait for changes to propagate in the specified service.
+// defaultTimeout is returned unless the service's timeout is overridden in provider configuration.
+func PropagationTimeout(ctx context.Context, servicePackageName string, defaultTimeout time.Duration) time.Duration {
+	if v, ok := ctx.Value(propagationTimeoutsKey).(map[string]time.Duration); ok {
+		if v, ok := v[servicePackageName]; ok {
+			return v
+		}
+	}
+
+	return defaultTimeout
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	errCodeInvalidGrantException = "InvalidGrantException"
+	errCodeUnauthorizedException = "UnauthorizedException"
+)
+
+// ssoTokenErrorMessages are the messages of the AWS SDK for Go v2 errors returned when the AWS IAM Identity Center access token
+// has expired or can't be refreshed.
+var ssoTokenErrorMessages = []string{
+	"the SSO session has expired or is invalid",
+	"refresh cached SSO token failed",
+	"cached SSO token is expired",
+	errCodeInvalidGrantException,
+}
+
+// ssoProfile is a shared configuration profile that sources credentials from AWS IAM Identity Center (SSO).
+type ssoProfile struct {
+	name        string
+	sessionName string // Empty for legacy configuration, whose access tokens can't be refreshed.
+}
+
+// ssoProfile returns the configured shared configuration profile if it sources credentials from AWS IAM Identity Center.
+func (c *Config) ssoProfile(ctx context.Context) *ssoProfile {
+	if c.AccessKey != "" {
+		return nil
+	}
+
+	sharedConfig, err := c.sharedConfigProfile(ctx)
+	if err != nil {
+		return nil
+	}
+
+	if sharedConfig.SSOSession == nil && sharedConfig.SSOStartURL == "" {
+		return nil
+	}
+
+	profile := &ssoProfile{
+		name:        sharedConfig.Profile,
+		sessionName: sharedConfig.SSOSessionName,
+	}
+
+	if profile.sessionName == "" {
+		tflog.Warn(ctx, "Profile uses legacy AWS IAM Identity Center (SSO) configuration, the SSO access token will not be refreshed automatically", map[string]any{
+			"tf_aws.profile": profile.name,
+		})
+	}
+
+	return profile
+}
+
+// loginCommand returns the AWS CLI command that signs in to AWS IAM Identity Center using the profile.
+func (p *ssoProfile) loginCommand() string {
+	if p.name == "default" {
+		return "aws sso login"
+	}
+
+	return "aws sso login --profile " + p.name
+}
+
+// loginDetail returns a description of how to sign in to AWS IAM Identity Center again.
+func (p *ssoProfile) loginDetail() string {
+	detail := fmt.Sprintf("The profile %q sources credentials from AWS IAM Identity Center (SSO) and its access token has expired or could not be refreshed. "+
+		"To sign in again, run:\n\n\t%s", p.name, p.loginCommand())
+
+	if p.sessionName == "" {
+		detail += "\n\nThe profile uses legacy SSO configuration, whose access tokens can't be refreshed automatically. " +
+			"Configure an sso-session section in the shared configuration file to enable automatic refresh."
+	}
+
+	return detail
+}
+
+// ssoLoginError is returned when credentials can't be retrieved for a profile that sources credentials from AWS IAM Identity Center.
+type ssoLoginError struct {
+	err     error
+	profile *ssoProfile
+}
+
+func (e *ssoLoginError) Error() string {
+	return fmt.Sprintf("%s\n\n%s", e.err, e.profile.loginDetail())
+}
+
+func (e *ssoLoginError) Unwrap() error {
+	return e.err
+}
+
+// ssoCredentialsProvider is an AWS SDK for Go v2 credentials provider that reports how to sign in to AWS IAM Identity Center again
+// when the SSO access token has expired and can't be refreshed, e.g. during a long-running apply.
+type ssoCredentialsProvider struct {
+	provider aws_sdkv2.CredentialsProvider
+	profile  *ssoProfile
+}
+
+func (p *ssoCredentialsProvider) Retrieve(ctx context.Context) (aws_sdkv2.Credentials, error) {
+	credentials, err := p.provider.Retrieve(ctx)
+
+	if isSSOTokenError(err) {
+		return credentials, &ssoLoginError{err: err, profile: p.profile}
+	}
+
+	return credentials, err
+}
+
+// IsCredentialsProvider returns whether the wrapped credentials provider is, or wraps, the target provider.
+func (p *ssoCredentialsProvider) IsCredentialsProvider(target aws_sdkv2.CredentialsProvider) bool {
+	return aws_sdkv2.IsCredentialsProvider(p.provider, target)
+}
+
+// isSSOTokenError returns whether the error indicates that the AWS IAM Identity Center access token has expired or can't be refreshed.
+func isSSOTokenError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errs.IsA[*ssocreds.InvalidTokenError](err) || tfawserr.ErrCodeEquals(err, errCodeInvalidGrantException, errCodeUnauthorizedException) {
+		return true
+	}
+
+	return isSSOTokenErrorMessage(err.Error())
+}
+
+// isSSOTokenErrorMessage returns whether the message, e.g. a diagnostic's detail, describes an expired or unrefreshable AWS IAM Identity Center access token.
+func isSSOTokenErrorMessage(message string) bool {
+	for _, v := range ssoTokenErrorMessages {
+		if strings.Contains(message, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// arnAttributes returns the names of the resource's top-level ARN-valued arguments.
+// Arguments with no validation of their own may accept values other than ARNs, for example names, and are not included.
+func arnAttributes(s map[string]*schema.Schema) []string {
+	var attrs []string
+
+	for k, v := range s {
+		if !verify.IsARNAttributeName(k) || (v.Computed && !v.Optional) {
+			continue
+		}
+
+		switch v.Type {
+		case schema.TypeString:
+			if v.ValidateFunc != nil || v.ValidateDiagFunc != nil {
+				attrs = append(attrs, k)
+			}
+		case schema.TypeList, schema.TypeSet:
+			if v, ok := v.Elem.(*schema.Schema); ok && v.Type == schema.TypeString && (v.ValidateFunc != nil || v.ValidateDiagFunc != nil) {
+				attrs = append(attrs, k)
+			}
+		}
+	}
+
+	return attrs
+}
+
+// arnCustomizeDiff returns a CustomizeDiff handler that validates the resource's new or changed ARN-valued arguments at plan time
+// and then invokes the specified handler, if any.
+func arnCustomizeDiff(f schema.CustomizeDiffFunc, attributes []string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if v, ok := meta.(*conns.AWSClient); ok && !d.GetRawConfig().IsNull() {
+			var errs []error
+
+			for _, name := range attributes {
+				if d.Id() != "" && !d.HasChange(name) {
+					continue
+				}
+
+				// Only configured, known values are validated.
+				var values []string
+				if attr := d.GetRawConfig().GetAttr(name); attr.IsKnown() && !attr.IsNull() {
+					if attr.Type().IsPrimitiveType() {
+						values = append(values, attr.AsString())
+					} else {
+						for it := attr.ElementIterator(); it.Next(); {
+							if _, e := it.Element(); e.IsKnown() && !e.IsNull() {
+								values = append(values, e.AsString())
+							}
+						}
+					}
+				}
+
+				for _, value := range values {
+					if value == "" {
+						continue
+					}
+
+					if err := verify.ValidateARNAttribute(name, value, v.Partition); err != nil {
+						errs = append(errs, err)
+					}
+				}
+			}
+
+			if err := errors.Join(errs...); err != nil {
+				return err
+			}
+		}
+
+		if f == nil {
+			return nil
+		}
+
+		return f(ctx, d, meta)
+	}
+}
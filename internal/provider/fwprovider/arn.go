@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// isARNAttribute returns whether a top-level resource schema attribute holds ARN-valued arguments.
+// Attributes with no validation or custom type of their own may accept values other than ARNs, for example names, and are not included.
+func isARNAttribute(ctx context.Context, attr any) bool {
+	switch v := attr.(type) {
+	case schema.StringAttribute:
+		return len(v.Validators) > 0 || v.CustomType != nil
+	case schema.ListAttribute:
+		if v.ElementType != nil && v.ElementType.TerraformType(ctx).Is(tftypes.String) {
+			return len(v.Validators) > 0 || v.CustomType != nil || !v.ElementType.Equal(types.StringType)
+		}
+	case schema.SetAttribute:
+		if v.ElementType != nil && v.ElementType.TerraformType(ctx).Is(tftypes.String) {
+			return len(v.Validators) > 0 || v.CustomType != nil || !v.ElementType.Equal(types.StringType)
+		}
+	}
+
+	return false
+}
+
+// validateARNAttributes validates a resource's new or changed top-level ARN-valued arguments at plan time.
+func validateARNAttributes(ctx context.Context, request resource.ModifyPlanRequest, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Nothing to validate when the resource is being destroyed.
+	if meta == nil || request.Plan.Raw.IsNull() || request.Config.Raw.IsNull() {
+		return diags
+	}
+
+	var config, state map[string]tftypes.Value
+	if err := request.Config.Raw.As(&config); err != nil {
+		return diags
+	}
+	if !request.State.Raw.IsNull() {
+		if err := request.State.Raw.As(&state); err != nil {
+			return diags
+		}
+	}
+
+	for name, attr := range request.Config.Schema.GetAttributes() {
+		if !verify.IsARNAttributeName(name) || !isARNAttribute(ctx, attr) {
+			continue
+		}
+
+		// Only configured, known values are validated.
+		v, ok := config[name]
+		if !ok || !v.IsKnown() || v.IsNull() {
+			continue
+		}
+		if v.Equal(state[name]) {
+			continue
+		}
+
+		var values []string
+		if v.Type().Is(tftypes.String) {
+			var s string
+			if err := v.As(&s); err == nil {
+				values = append(values, s)
+			}
+		} else {
+			var elems []tftypes.Value
+			if err := v.As(&elems); err == nil {
+				for _, e := range elems {
+					var s string
+					if e.IsKnown() && !e.IsNull() && e.As(&s) == nil {
+						values = append(values, s)
+					}
+				}
+			}
+		}
+
+		for _, value := range values {
+			if value == "" {
+				continue
+			}
+
+			if err := verify.ValidateARNAttribute(name, value, meta.Partition); err != nil {
+				diags.AddAttributeError(path.Root(name), "Invalid ARN", err.Error())
+			}
+		}
+	}
+
+	return diags
+}
@@ -338,8 +338,14 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	response.Diagnostics.Append(validateARNAttributes(ctx, request, w.meta)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
 	}
 }
@@ -467,6 +473,11 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 			return ctx, diags
 		}
 
+		// If tagging API calls are skipped and the R handler didn't set tags, keep the tags in state.
+		if tagsInContext.TagsOut.IsNone() && tagsInContext.SkipAPICalls {
+			return ctx, diags
+		}
+
 		// If the R handler didn't set tags, try and read them from the service API.
 		if tagsInContext.TagsOut.IsNone() {
 			if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
@@ -600,6 +611,13 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 			return ctx, diags
 		}
 
+		if !newTagsAll.Equal(oldTagsAll) && tagsInContext.SkipAPICalls {
+			// Without the tagging API tag changes can't be applied, so refuse them rather than record tags in state that aren't on the resource.
+			diags.AddError(fmt.Sprintf("updating tags for %s %s", serviceName, resourceName), "tag changes can't be applied when tagging API calls are skipped (skip_tag_api_calls)")
+
+			return ctx, diags
+		}
+
 		if !newTagsAll.Equal(oldTagsAll) {
 			if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
 				var identifier string
@@ -68,10 +68,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The endpoint URL used for all services that do not have an endpoint configured in the `endpoints` configuration block, e.g. `http://localhost:4566`. The placeholders `{service}` and `{region}` are replaced by the service name and the Region.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"http_audit_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which all AWS API requests and responses are appended in JSON Lines format. Credentials and secret values are redacted.",
+			},
 			"http_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTP requests when accessing the AWS API. Can also be set using the `HTTP_PROXY` or `http_proxy` environment variables.",
@@ -84,10 +92,25 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"max_backoff": schema.StringAttribute{
+				CustomType:  fwtypes.DurationType,
+				Optional:    true,
+				Description: "The maximum backoff delay between retries of an AWS API request. Valid time units are ns, us (or µs), ms, s, h, or m. Defaults to 5m.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
 			},
+			"service_max_retries": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Per-service overrides of `max_retries`. Keys are service names as used in the `endpoints` configuration block, e.g. `ec2` or `iam`.",
+			},
+			"service_use_fips_endpoint": schema.MapAttribute{
+				ElementType: types.BoolType,
+				Optional:    true,
+				Description: "Per-service overrides of `use_fips_endpoint`. Keys are service names as used in the `endpoints` configuration block, e.g. `ec2` or `iam`.",
+			},
 			"no_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
@@ -96,6 +119,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"propagation_timeouts": schema.MapAttribute{
+				ElementType: fwtypes.DurationType,
+				Optional:    true,
+				Description: "Per-service overrides of how long to wait for changes to propagate (eventual consistency). Keys are service names as used in the `endpoints` configuration block, e.g. `iam`, `kms` or `s3`. Valid time units are ns, us (or µs), ms, s, h, or m.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -134,7 +162,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 			"skip_metadata_api_check": schema.StringAttribute{
 				Optional:    true,
-				Description: "Skip the AWS Metadata API check. Used for AWS API implementations that do not have a metadata api endpoint.",
+				Description: "Skip the AWS Metadata API check. Used for AWS API implementations that do not have a metadata api endpoint. Disables all EC2 Instance Metadata Service lookups, overriding the `AWS_EC2_METADATA_DISABLED` environment variable.",
 			},
 			"skip_region_validation": schema.BoolAttribute{
 				Optional:    true,
@@ -144,6 +172,15 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Skip requesting the account ID. Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"skip_tag_api_calls": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip the separate tagging API calls made to list and update resource tags. Used when tagging APIs are denied, e.g. by a service control policy.",
+			},
+			"skip_tag_api_calls_resource_types": schema.MapAttribute{
+				ElementType: types.BoolType,
+				Optional:    true,
+				Description: "Per-resource type overrides of `skip_tag_api_calls`. Keys are resource type names, e.g. `aws_instance`.",
+			},
 			"sts_region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
@@ -164,12 +201,13 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"use_path_style": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set this to true to send all requests to the configured endpoints, using path-style addressing for S3 and not prefixing endpoint hostnames for other services. Useful with local AWS emulators.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -259,6 +297,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"excludes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_autoscaling_group`, to which default tags are not applied.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -280,6 +323,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag key regular expressions to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -288,6 +336,25 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"user_agent": schema.ListNestedBlock{
+				Description: "Product details appended to the User-Agent header of all AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comment": schema.StringAttribute{
+							Optional:    true,
+							Description: "User-Agent comment, without enclosing parentheses.",
+						},
+						"product_name": schema.StringAttribute{
+							Required:    true,
+							Description: "User-Agent product name.",
+						},
+						"product_version": schema.StringAttribute{
+							Optional:    true,
+							Description: "User-Agent product version.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -296,6 +363,13 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 // Terraform sends to the provider the values the user specified in the
 // provider configuration block.
 func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
+	// Mirror the primary provider, which defers all resources and data sources if its configuration is not yet known.
+	if request.ClientCapabilities.DeferralAllowed && !request.Config.Raw.IsFullyKnown() {
+		response.Deferred = &provider.Deferred{
+			Reason: provider.DeferredReasonProviderConfigUnknown,
+		}
+	}
+
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
 	response.DataSourceData = v
@@ -335,8 +409,9 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig, false)
 					ctx = meta.RegisterLogger(ctx)
+					ctx = meta.RegisterPropagationTimeouts(ctx)
 				}
 
 				return ctx
@@ -406,8 +481,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResourceType(typeName), meta.IgnoreTagsConfig, meta.SkipTagAPICalls(typeName))
 					ctx = meta.RegisterLogger(ctx)
+					ctx = meta.RegisterPropagationTimeouts(ctx)
 				}
 
 				return ctx
@@ -249,6 +249,15 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 				break
 			}
 
+			if tagsInContext.SkipAPICalls {
+				// Without the tagging API tag changes can't be applied, so refuse them rather than record tags in state that aren't on the resource.
+				if d.HasChange(names.AttrTagsAll) {
+					return ctx, sdkdiag.AppendErrorf(diags, "updating tags for %s %s (%s): tag changes can't be applied when tagging API calls are skipped (skip_tag_api_calls)", serviceName, resourceName, d.Id())
+				}
+
+				break
+			}
+
 			if d.GetRawPlan().GetAttr(names.AttrTagsAll).IsWhollyKnown() {
 				if d.HasChange(names.AttrTagsAll) {
 					if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
@@ -308,6 +317,19 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 
 			fallthrough
 		case Create, Update:
+			// If tagging API calls are skipped and the R handler didn't set tags, keep the configured tags.
+			if tagsInContext.TagsOut.IsNone() && tagsInContext.SkipAPICalls {
+				if why == Read {
+					return ctx, diags
+				}
+
+				if err := d.Set(names.AttrTagsAll, tagsInContext.TagsIn.UnwrapOrDefault().IgnoreConfig(tagsInContext.IgnoreConfig).Map()); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTagsAll, err)
+				}
+
+				return ctx, diags
+			}
+
 			// If the R handler didn't set tags, try and read them from the service API.
 			if tagsInContext.TagsOut.IsNone() {
 				if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
@@ -374,7 +396,7 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 	case Finally:
 		switch why {
 		case Update:
-			if r.tags.IdentifierAttribute != "" && !d.GetRawPlan().GetAttr(names.AttrTagsAll).IsWhollyKnown() {
+			if r.tags.IdentifierAttribute != "" && !tagsInContext.SkipAPICalls && !d.GetRawPlan().GetAttr(names.AttrTagsAll).IsWhollyKnown() {
 				ctx, diags = r.updateFunc(ctx, d, sp, r.tags, serviceName, resourceName, meta, diags)
 				ctx, diags = r.readFunc(ctx, d, sp, r.tags, serviceName, resourceName, meta, diags)
 			}
@@ -31,7 +31,7 @@ import (
 )
 
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
-// The provider instance is fully configured once the `ConfigureContextFunc` has been called.
+// The provider instance is fully configured once the `ConfigureProvider` function has been called.
 func New(ctx context.Context) (*schema.Provider, error) {
 	provider := &schema.Provider{
 		// This schema must match exactly the Terraform Protocol v6 (Terraform Plugin Framework) provider's schema.
@@ -65,6 +65,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"excludes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_autoscaling_group`, to which default tags are not applied.",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -86,6 +92,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The endpoint URL used for all services that do not have an endpoint configured in the `endpoints` configuration block, " +
+					"e.g. `http://localhost:4566`. The placeholders `{service}` and `{region}` are replaced by the service name and the Region.",
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -93,6 +105,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"allowed_account_ids"},
 			},
+			"http_audit_log_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which all AWS API requests and responses are appended in JSON Lines format. " +
+					"Credentials and secret values are redacted.",
+			},
 			"http_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -124,6 +142,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Resource tag key regular expressions to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -133,6 +160,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"max_backoff": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The maximum backoff delay between retries of an AWS API request. " +
+					"Valid time units are ns, us (or µs), ms, s, h, or m. Defaults to 5m.",
+				ValidateFunc: verify.ValidDuration,
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -140,6 +174,20 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"service_max_retries": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: "Per-service overrides of `max_retries`. Keys are service names " +
+					"as used in the `endpoints` configuration block, e.g. `ec2` or `iam`.",
+			},
+			"service_use_fips_endpoint": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+				Description: "Per-service overrides of `use_fips_endpoint`. Keys are service names " +
+					"as used in the `endpoints` configuration block, e.g. `ec2` or `iam`.",
+			},
 			"no_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -152,6 +200,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"propagation_timeouts": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Per-service overrides of how long to wait for changes to propagate (eventual consistency). " +
+					"Keys are service names as used in the `endpoints` configuration block, e.g. `iam`, `kms` or `s3`. " +
+					"Valid time units are ns, us (or µs), ms, s, h, or m.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -208,7 +264,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:     true,
 				ValidateFunc: nullable.ValidateTypeStringNullableBool,
 				Description: "Skip the AWS Metadata API check. " +
-					"Used for AWS API implementations that do not have a metadata api endpoint.",
+					"Used for AWS API implementations that do not have a metadata api endpoint. " +
+					"Disables all EC2 Instance Metadata Service lookups, overriding the `AWS_EC2_METADATA_DISABLED` environment variable.",
 			},
 			"skip_region_validation": {
 				Type:     schema.TypeBool,
@@ -222,6 +279,19 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Skip requesting the account ID. " +
 					"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"skip_tag_api_calls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Skip the separate tagging API calls made to list and update resource tags. " +
+					"Used when tagging APIs are denied, e.g. by a service control policy.",
+			},
+			"skip_tag_api_calls_resource_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+				Description: "Per-resource type overrides of `skip_tag_api_calls`. " +
+					"Keys are resource type names, e.g. `aws_instance`.",
+			},
 			"sts_region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -249,6 +319,36 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"use_path_style": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Set this to true to send all requests to the configured endpoints, using path-style addressing for S3 " +
+					"and not prefixing endpoint hostnames for other services. Useful with local AWS emulators.",
+			},
+			"user_agent": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Product details appended to the User-Agent header of all AWS API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comment": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "User-Agent comment, without enclosing parentheses.",
+						},
+						"product_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "User-Agent product name.",
+						},
+						"product_version": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "User-Agent product version.",
+						},
+					},
+				},
+			},
 		},
 
 		// Data sources and resources implemented using Terraform Plugin SDK
@@ -258,8 +358,19 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
-	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+	provider.ConfigureProvider = func(ctx context.Context, request schema.ConfigureProviderRequest, response *schema.ConfigureProviderResponse) {
+		// If the provider configuration depends on values that aren't yet known, e.g. a Region or IAM Role ARN from another resource,
+		// defer all resources and data sources to a later plan instead of configuring the provider with partial values.
+		if request.DeferralAllowed && !request.ResourceData.GetRawConfig().IsWhollyKnown() {
+			tflog.Info(ctx, "Provider configuration is not yet known, deferring")
+			response.Deferred = &schema.Deferred{
+				Reason: schema.DeferredReasonProviderConfigUnknown,
+			}
+			response.Meta = provider.Meta()
+			return
+		}
+
+		response.Meta, response.Diagnostics = configure(ctx, provider, request.ResourceData)
 	}
 
 	var errs []error
@@ -290,8 +401,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig, false)
 					ctx = v.RegisterLogger(ctx)
+					ctx = v.RegisterPropagationTimeouts(ctx)
 				}
 
 				return ctx
@@ -331,6 +443,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				r.ReadWithoutTimeout = ds.Read(v)
 			}
 
+			// Regional data sources can be read in a Region other than the provider's.
+			if isRegionalServicePackage(servicePackageName) {
+				if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+					addRegionToSchema(r, true)
+
+					if v := r.ReadWithoutTimeout; v != nil {
+						r.ReadWithoutTimeout = regionalHandler(v)
+					}
+				}
+			}
+
 			provider.DataSourcesMap[typeName] = r
 		}
 
@@ -367,8 +490,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(typeName), v.IgnoreTagsConfig, v.SkipTagAPICalls(typeName))
 					ctx = v.RegisterLogger(ctx)
+					ctx = v.RegisterPropagationTimeouts(ctx)
 				}
 
 				return ctx
@@ -435,12 +559,44 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
+
+			// New or changed ARN-valued arguments are validated at plan time.
+			if v := arnAttributes(r.SchemaMap()); len(v) > 0 {
+				r.CustomizeDiff = arnCustomizeDiff(r.CustomizeDiff, v)
+			}
 			for _, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
 					stateUpgrader.Upgrade = rs.StateUpgrade(v)
 				}
 			}
 
+			// Regional resources can be managed in a Region other than the provider's.
+			// The Region is switched before any interceptors are run.
+			if isRegionalServicePackage(servicePackageName) {
+				if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+					addRegionToSchema(r, false)
+
+					if v := r.CreateWithoutTimeout; v != nil {
+						r.CreateWithoutTimeout = regionalHandler(v)
+					}
+					if v := r.ReadWithoutTimeout; v != nil {
+						r.ReadWithoutTimeout = regionalHandler(v)
+					}
+					if v := r.UpdateWithoutTimeout; v != nil {
+						r.UpdateWithoutTimeout = regionalHandler(v)
+					}
+					if v := r.DeleteWithoutTimeout; v != nil {
+						r.DeleteWithoutTimeout = regionalHandler(v)
+					}
+					if v := r.Importer; v != nil {
+						if v := v.StateContext; v != nil {
+							r.Importer.StateContext = regionalImporter(v)
+						}
+					}
+					r.CustomizeDiff = regionalCustomizeDiff(r.CustomizeDiff)
+				}
+			}
+
 			provider.ResourcesMap[typeName] = r
 		}
 	}
@@ -450,7 +606,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	}
 
 	// Set the provider Meta (instance data) here.
-	// It will be overwritten by the result of the call to ConfigureContextFunc,
+	// It will be overwritten by the result of the call to ConfigureProvider,
 	// but can be used pre-configuration by other (non-primary) provider servers.
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
@@ -480,6 +636,8 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		EndpointURL:                    d.Get("endpoint_url").(string),
+		HTTPAuditLogFile:               d.Get("http_audit_log_file").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
@@ -490,12 +648,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipTagAPICalls:                d.Get("skip_tag_api_calls").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
 		TerraformVersion:               terraformVersion,
 		Token:                          d.Get("token").(string),
 		TokenBucketRateLimiterCapacity: d.Get("token_bucket_rate_limiter_capacity").(int),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		UsePathStyle:                   d.Get("use_path_style").(bool),
 	}
 
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
@@ -515,13 +675,22 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -567,10 +736,50 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("skip_tag_api_calls_resource_types"); ok && len(v.(map[string]interface{})) > 0 {
+		config.SkipTagAPICallsResourceTypes = flex.ExpandBoolValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("user_agent"); ok && len(v.([]interface{})) > 0 {
+		config.UserAgent = expandUserAgentProducts(ctx, v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.Get("max_backoff").(string); ok && v != "" {
+		maxBackoff, _ := time.ParseDuration(v)
+		config.MaxBackoff = maxBackoff
+	}
+
+	if v, ok := d.GetOk("service_max_retries"); ok && len(v.(map[string]interface{})) > 0 {
+		serviceMaxRetries, dx := expandServiceMaxRetries(ctx, v.(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceMaxRetries = serviceMaxRetries
+	}
+
+	if v, ok := d.GetOk("service_use_fips_endpoint"); ok && len(v.(map[string]interface{})) > 0 {
+		serviceUseFIPSEndpoint, dx := expandServiceUseFIPSEndpoint(ctx, v.(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceUseFIPSEndpoint = serviceUseFIPSEndpoint
+	}
+
+	if v, ok := d.GetOk("propagation_timeouts"); ok && len(v.(map[string]interface{})) > 0 {
+		propagationTimeouts, dx := expandPropagationTimeouts(ctx, v.(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.PropagationTimeouts = propagationTimeouts
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -607,7 +816,6 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -849,6 +1057,10 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["excludes"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.Excludes = flex.ExpandStringValueSet(v)
+	}
+
 	return defaultConfig
 }
 
@@ -867,9 +1079,137 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, v.List())
 	}
 
+	if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+		for _, v := range flex.ExpandStringValueSet(v) {
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, regexache.MustCompile(v))
+		}
+	}
+
 	return ignoreConfig
 }
 
+func expandUserAgentProducts(_ context.Context, tfList []interface{}) awsbase.UserAgentProducts {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var userAgentProducts awsbase.UserAgentProducts
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		userAgentProduct := awsbase.UserAgentProduct{}
+
+		if v, ok := tfMap["comment"].(string); ok && v != "" {
+			userAgentProduct.Comment = v
+		}
+
+		if v, ok := tfMap["product_name"].(string); ok && v != "" {
+			userAgentProduct.Name = v
+		}
+
+		if v, ok := tfMap["product_version"].(string); ok && v != "" {
+			userAgentProduct.Version = v
+		}
+
+		userAgentProducts = append(userAgentProducts, userAgentProduct)
+	}
+
+	return userAgentProducts
+}
+
+func expandServiceMaxRetries(_ context.Context, tfMap map[string]interface{}) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceMaxRetriesPath := cty.GetAttrPath("service_max_retries")
+	serviceMaxRetries := make(map[string]int)
+
+	for k, v := range tfMap {
+		elementPath := serviceMaxRetriesPath.IndexString(k)
+
+		pkg, err := names.ProviderPackageForAlias(k)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath, "Unknown service name %q.", k))
+			continue
+		}
+
+		maxRetries := v.(int)
+		if maxRetries < 1 {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath, "Value for %q must be at least 1, got %d.", k, maxRetries))
+			continue
+		}
+
+		serviceMaxRetries[pkg] = maxRetries
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return serviceMaxRetries, diags
+}
+
+func expandServiceUseFIPSEndpoint(_ context.Context, tfMap map[string]interface{}) (map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceUseFIPSEndpointPath := cty.GetAttrPath("service_use_fips_endpoint")
+	serviceUseFIPSEndpoint := make(map[string]bool)
+
+	for k, v := range tfMap {
+		pkg, err := names.ProviderPackageForAlias(k)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(serviceUseFIPSEndpointPath.IndexString(k), "Unknown service name %q.", k))
+			continue
+		}
+
+		serviceUseFIPSEndpoint[pkg] = v.(bool)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return serviceUseFIPSEndpoint, diags
+}
+
+func expandPropagationTimeouts(_ context.Context, tfMap map[string]interface{}) (map[string]time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	propagationTimeoutsPath := cty.GetAttrPath("propagation_timeouts")
+	propagationTimeouts := make(map[string]time.Duration)
+
+	for k, v := range tfMap {
+		elementPath := propagationTimeoutsPath.IndexString(k)
+
+		pkg, err := names.ProviderPackageForAlias(k)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath, "Unknown service name %q.", k))
+			continue
+		}
+
+		timeout, err := time.ParseDuration(v.(string))
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath, "Value for %q must be a valid duration, got %q.", k, v))
+			continue
+		}
+		if timeout <= 0 {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath, "Value for %q must be positive, got %q.", k, v))
+			continue
+		}
+
+		propagationTimeouts[pkg] = timeout
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return propagationTimeouts, diags
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -8,10 +8,15 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -32,6 +37,58 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderConfigureDeferred(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	p, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The provider's Region depends on a value that is not yet known.
+	configType := schema.InternalMap(p.Schema).CoreConfigSchema().ImpliedType()
+	attributes := make(map[string]cty.Value)
+	for k, v := range configType.AttributeTypes() {
+		attributes[k] = cty.NullVal(v)
+	}
+	attributes["region"] = cty.UnknownVal(cty.String)
+	config, err := msgpack.Marshal(cty.ObjectVal(attributes), configType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := schema.NewGRPCProviderServer(p)
+
+	configureResponse, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		ClientCapabilities: &tfprotov5.ConfigureProviderClientCapabilities{
+			DeferralAllowed: true,
+		},
+		Config: &tfprotov5.DynamicValue{MsgPack: config},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(configureResponse.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", configureResponse.Diagnostics)
+	}
+
+	readResponse, err := server.ReadDataSource(ctx, &tfprotov5.ReadDataSourceRequest{
+		TypeName: "aws_vpc",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if readResponse.Deferred == nil {
+		t.Fatal("expected deferred response")
+	}
+	if got, expected := readResponse.Deferred.Reason, tfprotov5.DeferredReasonProviderConfigUnknown; got != expected {
+		t.Errorf("deferred reason: got %s, expected %s", got, expected)
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -59,6 +116,122 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, diags := expandServiceMaxRetries(ctx, map[string]interface{}{
+		"ec2":            5,
+		"cloudwatchlogs": 10,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]int{
+		names.EC2:  5,
+		names.Logs: 10,
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected result difference: %s", diff)
+	}
+
+	for _, tfMap := range []map[string]interface{}{
+		{"notaservice": 5},
+		{"iam": 0},
+	} {
+		if _, diags := expandServiceMaxRetries(ctx, tfMap); !diags.HasError() {
+			t.Errorf("expected error for %v", tfMap)
+		}
+	}
+}
+
+func TestExpandServiceUseFIPSEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, diags := expandServiceUseFIPSEndpoint(ctx, map[string]interface{}{
+		"ec2": true,
+		"sso": false,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]bool{
+		names.EC2: true,
+		names.SSO: false,
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected result difference: %s", diff)
+	}
+
+	if _, diags := expandServiceUseFIPSEndpoint(ctx, map[string]interface{}{"notaservice": true}); !diags.HasError() {
+		t.Error("expected error for unknown service name")
+	}
+}
+
+func TestExpandPropagationTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, diags := expandPropagationTimeouts(ctx, map[string]interface{}{
+		"iam": "5m",
+		"kms": "90s",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]time.Duration{
+		names.IAM: 5 * time.Minute,
+		names.KMS: 90 * time.Second,
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected result difference: %s", diff)
+	}
+
+	for _, tfMap := range []map[string]interface{}{
+		{"notaservice": "5m"},
+		{"s3": "five minutes"},
+		{"s3": "0s"},
+	} {
+		if _, diags := expandPropagationTimeouts(ctx, tfMap); !diags.HasError() {
+			t.Errorf("expected error for %v", tfMap)
+		}
+	}
+}
+
+func TestExpandUserAgentProducts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results := expandUserAgentProducts(ctx, []interface{}{
+		map[string]interface{}{
+			"product_name":    "my-platform",
+			"product_version": "1.2.3",
+			"comment":         "stack:network",
+		},
+		map[string]interface{}{
+			"product_name":    "my-team",
+			"product_version": "",
+			"comment":         "",
+		},
+	})
+
+	expected := awsbase.UserAgentProducts{
+		{Name: "my-platform", Version: "1.2.3", Comment: "stack:network"},
+		{Name: "my-team"},
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected result difference: %s", diff)
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
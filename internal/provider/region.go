@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// globalServicePackages are the service packages whose resources are not Regional.
+// Their resources and data sources do not support the per-resource `region` argument.
+var globalServicePackages = []string{
+	names.Account,
+	names.Budgets,
+	names.CE,
+	names.CloudFront,
+	names.CUR,
+	names.GlobalAccelerator,
+	names.IAM,
+	names.NetworkManager,
+	names.Organizations,
+	names.Pricing,
+	names.Route53,
+	names.Route53Domains,
+	names.Route53RecoveryControlConfig,
+	names.Route53RecoveryReadiness,
+	names.Shield,
+	names.WAF,
+}
+
+// isRegionalServicePackage returns whether the specified service package's resources are Regional.
+func isRegionalServicePackage(servicePackageName string) bool {
+	return !slices.Contains(globalServicePackages, servicePackageName)
+}
+
+// regionSchema returns the schema of the per-resource `region` argument.
+func regionSchema(dataSource bool) *schema.Schema {
+	v := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+	}
+	if !dataSource {
+		v.ForceNew = true
+	}
+
+	return v
+}
+
+// addRegionToSchema adds the per-resource `region` argument to the resource's schema.
+func addRegionToSchema(r *schema.Resource, dataSource bool) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := f()
+			s[names.AttrRegion] = regionSchema(dataSource)
+
+			return s
+		}
+	} else {
+		r.Schema[names.AttrRegion] = regionSchema(dataSource)
+	}
+}
+
+// regionalMeta returns provider instance data that makes AWS API calls in the specified Region.
+func regionalMeta(ctx context.Context, region string, meta any) any {
+	if v, ok := meta.(*conns.AWSClient); ok {
+		return v.ForRegion(ctx, region)
+	}
+
+	return meta
+}
+
+// regionalHandler returns a CRUD handler that invokes the specified handler in the resource's configured Region.
+// The effective Region is recorded in state.
+func regionalHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](f F) F {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		region := d.Get(names.AttrRegion).(string)
+		if v, ok := meta.(*conns.AWSClient); ok {
+			if err := v.ValidateRegion(region); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		meta = regionalMeta(ctx, region, meta)
+
+		diags = f(ctx, d, meta)
+
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		if v, ok := meta.(*conns.AWSClient); ok {
+			if err := d.Set(names.AttrRegion, v.Region); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+
+		return diags
+	}
+}
+
+// regionalCustomizeDiff returns a CustomizeDiff handler that defaults the resource's Region to the provider's Region,
+// rejects Regions outside the provider's partition and then invokes the specified handler, if any, in the resource's configured Region.
+func regionalCustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if v, ok := meta.(*conns.AWSClient); ok {
+			if d.GetRawConfig().GetAttr(names.AttrRegion).IsNull() {
+				// No Region configured, so the resource is in the provider's Region.
+				// If that has changed since the resource was created, the resource is replaced.
+				if old := d.Get(names.AttrRegion).(string); d.Id() == "" || (old != "" && old != v.Region) {
+					if err := d.SetNew(names.AttrRegion, v.Region); err != nil {
+						return err
+					}
+				}
+			} else if err := v.ValidateRegion(d.Get(names.AttrRegion).(string)); err != nil {
+				return err
+			}
+		}
+
+		if f == nil {
+			return nil
+		}
+
+		return f(ctx, d, regionalMeta(ctx, d.Get(names.AttrRegion).(string), meta))
+	}
+}
+
+// regionalImporter returns an import handler that invokes the specified handler in the resource's configured Region.
+// The Region can be specified by suffixing the import ID with `@<region>`.
+func regionalImporter(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region, found := cutRegionSuffix(d.Id()); found {
+			if v, ok := meta.(*conns.AWSClient); ok {
+				if err := v.ValidateRegion(region); err != nil {
+					return nil, err
+				}
+			}
+
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, err
+			}
+		}
+
+		meta = regionalMeta(ctx, d.Get(names.AttrRegion).(string), meta)
+
+		results, err := f(ctx, d, meta)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := meta.(*conns.AWSClient); ok {
+			for _, result := range results {
+				if result.Get(names.AttrRegion).(string) == "" {
+					if err := result.Set(names.AttrRegion, v.Region); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+
+		return results, nil
+	}
+}
+
+// cutRegionSuffix splits an import ID of the form `<id>@<region>`.
+func cutRegionSuffix(id string) (string, string, bool) {
+	i := strings.LastIndex(id, "@")
+	if i < 0 {
+		return id, "", false
+	}
+
+	region := id[i+1:]
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); len(errs) > 0 {
+		return id, "", false
+	}
+
+	return id[:i], region, true
+}
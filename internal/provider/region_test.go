@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestCutRegionSuffix(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id             string
+		expectedID     string
+		expectedRegion string
+		expectedFound  bool
+	}{
+		{
+			id:         "vpc-12345678",
+			expectedID: "vpc-12345678",
+		},
+		{
+			id:             "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-12345678",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+			expectedFound:  true,
+		},
+		{
+			id:             "user@example.com@us-gov-west-1", //lintignore:AWSAT003
+			expectedID:     "user@example.com",
+			expectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+			expectedFound:  true,
+		},
+		{
+			id:         "user@example.com",
+			expectedID: "user@example.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.id, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, found := cutRegionSuffix(testCase.id)
+
+			if id != testCase.expectedID {
+				t.Errorf("id: got %s, expected %s", id, testCase.expectedID)
+			}
+			if region != testCase.expectedRegion {
+				t.Errorf("region: got %s, expected %s", region, testCase.expectedRegion)
+			}
+			if found != testCase.expectedFound {
+				t.Errorf("found: got %t, expected %t", found, testCase.expectedFound)
+			}
+		})
+	}
+}
+
+func TestProviderRegionSchema(t *testing.T) {
+	t.Parallel()
+
+	p, err := New(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Regional resource.
+	v, ok := p.ResourcesMap["aws_vpc"].SchemaMap()[names.AttrRegion]
+	if !ok {
+		t.Fatalf("aws_vpc: no %s attribute", names.AttrRegion)
+	}
+	if !v.Optional || !v.Computed || !v.ForceNew {
+		t.Errorf("aws_vpc: %s attribute must be Optional, Computed and ForceNew", names.AttrRegion)
+	}
+
+	// Regional data source.
+	v, ok = p.DataSourcesMap["aws_vpc"].SchemaMap()[names.AttrRegion]
+	if !ok {
+		t.Fatalf("data.aws_vpc: no %s attribute", names.AttrRegion)
+	}
+	if !v.Optional || !v.Computed || v.ForceNew {
+		t.Errorf("data.aws_vpc: %s attribute must be Optional and Computed", names.AttrRegion)
+	}
+
+	// Global resource.
+	if _, ok := p.ResourcesMap["aws_iam_role"].SchemaMap()[names.AttrRegion]; ok {
+		t.Errorf("aws_iam_role: unexpected %s attribute", names.AttrRegion)
+	}
+
+	// Resource that defines its own region attribute.
+	if v := p.ResourcesMap["aws_s3_bucket"].SchemaMap()[names.AttrRegion]; v.Optional {
+		t.Errorf("aws_s3_bucket: %s attribute must not be Optional", names.AttrRegion)
+	}
+}
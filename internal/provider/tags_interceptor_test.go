@@ -89,7 +89,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
 		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
-			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig, false)
 		}
 
 		return ctx
@@ -107,7 +107,53 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
-type resourceData struct{}
+func TestTagsResourceInterceptorSkipAPICalls(t *testing.T) {
+	t.Parallel()
+
+	tags := tagsResourceInterceptor{
+		tags: &types.ServicePackageResourceTags{
+			IdentifierAttribute: "id",
+		},
+		updateFunc: tagsUpdateFunc,
+		readFunc:   tagsReadFunc,
+	}
+
+	conn := &conns.AWSClient{
+		ServicePackages: map[string]conns.ServicePackage{
+			"Test": &mockService{},
+		},
+	}
+
+	ctx := conns.NewResourceContext(context.Background(), "Test", "aws_test")
+	ctx = tftags.NewContext(ctx, nil, nil, true)
+	d := &resourceData{}
+
+	for _, why := range []why{Read, Update} {
+		var diags diag.Diagnostics
+		for _, when := range []when{After, Finally} {
+			_, diags = tags.run(ctx, d, conn, when, why, diags)
+		}
+		if got, want := len(diags), 0; got != want {
+			t.Errorf("length of diags = %v, want %v", got, want)
+		}
+	}
+
+	// Tag changes are refused.
+	d = &resourceData{hasChange: true}
+
+	var diags diag.Diagnostics
+	_, diags = tags.run(ctx, d, conn, Before, Update, diags)
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("length of diags = %v, want %v", got, want)
+	}
+	if !diags.HasError() {
+		t.Errorf("expected error diagnostic")
+	}
+}
+
+type resourceData struct {
+	hasChange bool
+}
 
 func (d *resourceData) GetRawConfig() cty.Value {
 	return cty.ObjectVal(map[string]cty.Value{
@@ -146,5 +192,5 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 }
 
 func (d *resourceData) HasChange(key string) bool {
-	return false
+	return d.hasChange
 }
@@ -138,32 +138,27 @@ func resourceGroup() *schema.Resource {
 			"initial_lifecycle_hook": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"default_result": {
 							Type:             schema.TypeString,
 							Optional:         true,
 							Computed:         true,
-							ForceNew:         true,
 							ValidateDiagFunc: enum.Validate[lifecycleHookDefaultResult](),
 						},
 						"heartbeat_timeout": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.IntBetween(30, 7200),
 						},
 						"lifecycle_transition": {
 							Type:             schema.TypeString,
 							Required:         true,
-							ForceNew:         true,
 							ValidateDiagFunc: enum.Validate[lifecycleHookLifecycleTransition](),
 						},
 						names.AttrName: {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 							ValidateFunc: validation.All(
 								validation.StringLenBetween(1, 255),
 								validation.StringMatch(regexache.MustCompile(`[A-Za-z0-9\-_\/]+`),
@@ -173,18 +168,15 @@ func resourceGroup() *schema.Resource {
 						"notification_metadata": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						"notification_target_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: verify.ValidARN,
 						},
 						names.AttrRoleARN: {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: verify.ValidARN,
 						},
 					},
@@ -1158,16 +1150,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if twoPhases {
 		for _, input := range expandPutLifecycleHookInputs(asgName, initialLifecycleHooks) {
-			const (
-				timeout = 5 * time.Minute
-			)
-			_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, timeout,
-				func() (interface{}, error) {
-					return conn.PutLifecycleHook(ctx, input)
-				},
-				errCodeValidationError, "Unable to publish test message to notification target")
-
-			if err != nil {
+			if err := putGroupLifecycleHook(ctx, conn, input); err != nil {
 				return sdkdiag.AppendErrorf(diags, "creating Auto Scaling Group (%s) Lifecycle Hook: %s", d.Id(), err)
 			}
 		}
@@ -1355,6 +1338,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChangesExcept(
 		"enabled_metrics",
+		"initial_lifecycle_hook",
 		"load_balancers",
 		"suspended_processes",
 		"tag",
@@ -1491,6 +1475,44 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	if d.HasChange("initial_lifecycle_hook") {
+		o, n := d.GetChange("initial_lifecycle_hook")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		hookNames := make(map[string]struct{})
+		for _, tfMapRaw := range ns.List() {
+			hookNames[tfMapRaw.(map[string]interface{})[names.AttrName].(string)] = struct{}{}
+		}
+
+		// Hooks that are being modified are updated in-place by PutLifecycleHook.
+		for _, tfMapRaw := range os.Difference(ns).List() {
+			name := tfMapRaw.(map[string]interface{})[names.AttrName].(string)
+
+			if _, ok := hookNames[name]; ok {
+				continue
+			}
+
+			_, err := conn.DeleteLifecycleHook(ctx, &autoscaling.DeleteLifecycleHookInput{
+				AutoScalingGroupName: aws.String(d.Id()),
+				LifecycleHookName:    aws.String(name),
+			})
+
+			if tfawserr.ErrMessageContains(err, errCodeValidationError, "No Lifecycle Hook found") {
+				continue
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting Auto Scaling Group (%s) Lifecycle Hook (%s): %s", d.Id(), name, err)
+			}
+		}
+
+		for _, input := range expandPutLifecycleHookInputs(d.Id(), ns.Difference(os).List()) {
+			if err := putGroupLifecycleHook(ctx, conn, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Auto Scaling Group (%s) Lifecycle Hook: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("traffic_source") {
 		o, n := d.GetChange("traffic_source")
 		if o == nil {
@@ -3176,6 +3198,19 @@ func expandMixedInstancesPolicy(tfMap map[string]interface{}, hasDefaultVersion
 	return apiObject
 }
 
+func putGroupLifecycleHook(ctx context.Context, conn *autoscaling.Client, input *autoscaling.PutLifecycleHookInput) error {
+	const (
+		timeout = 5 * time.Minute
+	)
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.PutLifecycleHook(ctx, input)
+		},
+		errCodeValidationError, "Unable to publish test message to notification target")
+
+	return err
+}
+
 func expandPutLifecycleHookInput(name string, tfMap map[string]interface{}) *autoscaling.PutLifecycleHookInput {
 	if tfMap == nil {
 		return nil
@@ -1218,7 +1218,7 @@ func TestAccAutoScalingGroup_initialLifecycleHook(t *testing.T) {
 				Config: testAccGroupConfig_initialLifecycleHook(rName, 40),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
 					},
 				},
 				Check: resource.ComposeTestCheckFunc(
@@ -8,6 +8,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -15,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
 	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -27,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/mattbaird/jsonpatch"
 )
@@ -47,8 +52,10 @@ func resourceResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 			},
 			names.AttrProperties: {
 				Type:     schema.TypeString,
@@ -292,6 +299,23 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
 	}
 
+	cfResource, err := cfResourceSchema.Resource()
+
+	if err != nil {
+		return fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	var newDocument map[string]any
+
+	if err := json.Unmarshal([]byte(newDesiredState), &newDocument); err != nil {
+		return fmt.Errorf("parsing desired_state JSON: %w", err)
+	}
+
+	// Report all missing required properties together, with their names, before any other schema violations.
+	if missing := missingRequiredProperties(cfResource, newDocument); len(missing) > 0 {
+		return fmt.Errorf("desired_state is missing required CloudFormation Resource properties: %s", strings.Join(missing, ", "))
+	}
+
 	if err := cfResourceSchema.ValidateConfigurationDocument(newDesiredState); err != nil {
 		return fmt.Errorf("validating desired_state against CloudFormation Resource Schema: %w", err)
 	}
@@ -301,29 +325,73 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return nil
 	}
 
-	cfResource, err := cfResourceSchema.Resource()
+	var oldDocument map[string]any
 
-	if err != nil {
-		return fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
+	if err := json.Unmarshal([]byte(oldDesiredStateRaw.(string)), &oldDocument); err != nil {
+		return fmt.Errorf("parsing desired_state JSON: %w", err)
 	}
 
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+	if path, ok := changedCreateOnlyProperty(cfResource, oldDocument, newDocument); ok {
+		tflog.Debug(ctx, "CloudFormation Resource create-only property changed", map[string]any{
+			"tf_aws.cloudcontrol.property": path,
+		})
 
-	if err != nil {
-		return fmt.Errorf("creating desired_state JSON Patch: %w", err)
+		if err := diff.ForceNew("desired_state"); err != nil {
+			return fmt.Errorf("setting desired_state ForceNew: %w", err)
+		}
 	}
 
-	for _, patch := range patches {
-		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
-			if err := diff.ForceNew("desired_state"); err != nil {
-				return fmt.Errorf("setting desired_state ForceNew: %w", err)
-			}
+	return nil
+}
 
-			break
+// missingRequiredProperties returns the names of the CloudFormation resource's required properties that are not set in the document.
+func missingRequiredProperties(cfResource *cfschema.Resource, document map[string]any) []string {
+	var missing []string
+
+	for _, name := range cfResource.Required {
+		if _, ok := document[name]; !ok {
+			missing = append(missing, name)
 		}
 	}
 
-	return nil
+	slices.Sort(missing)
+
+	return missing
+}
+
+// changedCreateOnlyProperty returns the first of the CloudFormation resource's create-only properties whose value differs between the documents.
+// Changes nested within a create-only property, and changes that add or remove it, are detected.
+func changedCreateOnlyProperty(cfResource *cfschema.Resource, old, new map[string]any) (string, bool) {
+	for _, createOnlyProperty := range cfResource.CreateOnlyProperties {
+		path := createOnlyProperty.Path()
+		oldValue, oldOK := documentValue(old, path)
+		newValue, newOK := documentValue(new, path)
+
+		if oldOK != newOK || !reflect.DeepEqual(oldValue, newValue) {
+			return createOnlyProperty.String(), true
+		}
+	}
+
+	return "", false
+}
+
+// documentValue returns the value at the specified property path in a JSON document.
+func documentValue(document map[string]any, path []string) (any, bool) {
+	var v any = document
+
+	for _, segment := range path {
+		m, ok := v.(map[string]any)
+
+		if !ok {
+			return nil, false
+		}
+
+		if v, ok = m[segment]; !ok {
+			return nil, false
+		}
+	}
+
+	return v, true
 }
 
 func findResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, typeName, typeVersionID, roleARN string) (*types.ResourceDescription, error) {
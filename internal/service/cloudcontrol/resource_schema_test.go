@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+)
+
+func TestMissingRequiredProperties(t *testing.T) {
+	t.Parallel()
+
+	cfResource := &cfschema.Resource{
+		Required: []string{"Name", "Engine", "Version"},
+	}
+
+	got := missingRequiredProperties(cfResource, map[string]any{"Engine": "example"})
+	expected := []string{"Name", "Version"}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected missing properties: %s", diff)
+	}
+}
+
+func TestChangedCreateOnlyProperty(t *testing.T) {
+	t.Parallel()
+
+	cfResource := &cfschema.Resource{
+		CreateOnlyProperties: cfschema.PropertyJsonPointers{
+			"/properties/Name",
+			"/properties/Config/Engine",
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		old          string
+		new          string
+		expectedPath string
+		expectedOK   bool
+	}{
+		{
+			name: "no change",
+			old:  `{"Name":"a","Config":{"Engine":"x"},"Description":"d"}`,
+			new:  `{"Description":"d","Config":{"Engine":"x"},"Name":"a"}`,
+		},
+		{
+			name: "updatable property",
+			old:  `{"Name":"a","Description":"d1"}`,
+			new:  `{"Name":"a","Description":"d2"}`,
+		},
+		{
+			name:         "top-level property",
+			old:          `{"Name":"a"}`,
+			new:          `{"Name":"b"}`,
+			expectedPath: "/properties/Name",
+			expectedOK:   true,
+		},
+		{
+			name:         "nested property",
+			old:          `{"Name":"a","Config":{"Engine":"x","Size":1}}`,
+			new:          `{"Name":"a","Config":{"Engine":"y","Size":1}}`,
+			expectedPath: "/properties/Config/Engine",
+			expectedOK:   true,
+		},
+		{
+			name: "sibling of nested property",
+			old:  `{"Name":"a","Config":{"Engine":"x","Size":1}}`,
+			new:  `{"Name":"a","Config":{"Engine":"x","Size":2}}`,
+		},
+		{
+			name:         "property added",
+			old:          `{"Config":{"Engine":"x"}}`,
+			new:          `{"Name":"a","Config":{"Engine":"x"}}`,
+			expectedPath: "/properties/Name",
+			expectedOK:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var old, new map[string]any
+			if err := json.Unmarshal([]byte(testCase.old), &old); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(testCase.new), &new); err != nil {
+				t.Fatal(err)
+			}
+
+			path, ok := changedCreateOnlyProperty(cfResource, old, new)
+
+			if ok != testCase.expectedOK {
+				t.Errorf("ok: got %t, expected %t", ok, testCase.expectedOK)
+			}
+			if path != testCase.expectedPath {
+				t.Errorf("path: got %s, expected %s", path, testCase.expectedPath)
+			}
+		})
+	}
+}
@@ -82,6 +82,15 @@ func ResourceAMI() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"deregistration_protection_with_cooldown": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"deregistration_protection"},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -382,6 +391,12 @@ func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.Get("deregistration_protection").(bool) {
+		if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s): %s", name, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -429,6 +444,9 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("boot_mode", image.BootMode)
 	d.Set(names.AttrDescription, image.Description)
 	d.Set("deprecation_time", image.DeprecationTime)
+	protection := aws.StringValue(image.DeregistrationProtection)
+	d.Set("deregistration_protection", strings.HasPrefix(protection, imageDeregistrationProtectionEnabled))
+	d.Set("deregistration_protection_with_cooldown", protection == imageDeregistrationProtectionEnabledWithCooldown)
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
 	d.Set("image_location", image.ImageLocation)
@@ -485,6 +503,18 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChanges("deregistration_protection", "deregistration_protection_with_cooldown") {
+		if d.Get("deregistration_protection").(bool) {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := disableImageDeregistrationProtection(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -602,6 +632,52 @@ func disableImageDeprecation(ctx context.Context, conn *ec2.EC2, id string) erro
 	return nil
 }
 
+const (
+	imageDeregistrationProtectionEnabled             = "enabled"
+	imageDeregistrationProtectionEnabledWithCooldown = "enabled-with-cooldown"
+)
+
+func enableImageDeregistrationProtection(ctx context.Context, conn *ec2.EC2, id string, withCooldown bool) error {
+	input := &ec2.EnableImageDeregistrationProtectionInput{
+		ImageId:      aws.String(id),
+		WithCooldown: aws.Bool(withCooldown),
+	}
+
+	_, err := conn.EnableImageDeregistrationProtectionWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: %w", err)
+	}
+
+	err = waitImageDeregistrationProtectionUpdated(ctx, conn, id, true)
+
+	if err != nil {
+		return fmt.Errorf("enabling deregistration protection: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+func disableImageDeregistrationProtection(ctx context.Context, conn *ec2.EC2, id string) error {
+	input := &ec2.DisableImageDeregistrationProtectionInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImageDeregistrationProtectionWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("disabling deregistration protection: %w", err)
+	}
+
+	err = waitImageDeregistrationProtectionUpdated(ctx, conn, id, false)
+
+	if err != nil {
+		return fmt.Errorf("disabling deregistration protection: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func expandBlockDeviceMappingForAMIEBSBlockDevice(tfMap map[string]interface{}) *ec2.BlockDeviceMapping {
 	if tfMap == nil {
 		return nil
@@ -911,3 +987,24 @@ func waitImageDeprecationTimeDisabled(ctx context.Context, conn *ec2.EC2, imageI
 		},
 	)
 }
+
+func waitImageDeregistrationProtectionUpdated(ctx context.Context, conn *ec2.EC2, imageID string, enabled bool) error {
+	return tfresource.WaitUntil(ctx, imageDeprecationPropagationTimeout, func() (bool, error) {
+		output, err := FindImageByID(ctx, conn, imageID)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return strings.HasPrefix(aws.StringValue(output.DeregistrationProtection), imageDeregistrationProtectionEnabled) == enabled, nil
+	},
+		tfresource.WaitOpts{
+			Delay:      amiRetryDelay,
+			MinTimeout: amiRetryMinTimeout,
+		},
+	)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2CapacityBlockReservation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Purchasing a Capacity Block incurs the full upfront cost of the reservation and it can't be cancelled.
+	offeringID := acctest.SkipIfEnvVarNotSet(t, "EC2_CAPACITY_BLOCK_OFFERING_ID")
+	resourceName := "aws_ec2_capacity_block_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityBlockReservationConfig_basic(offeringID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityBlockReservationExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ec2", regexache.MustCompile(`capacity-reservation/cr-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrAvailabilityZone),
+					resource.TestCheckResourceAttr(resourceName, "capacity_block_offering_id", offeringID),
+					resource.TestCheckResourceAttrSet(resourceName, "end_date"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrInstanceCount),
+					resource.TestCheckResourceAttr(resourceName, "instance_platform", "Linux/UNIX"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrInstanceType),
+					resource.TestCheckResourceAttr(resourceName, "reservation_type", "capacity-block"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityBlockReservationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindCapacityReservationByIDV2(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCapacityBlockReservationConfig_basic(offeringID string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_capacity_block_reservation" "test" {
+  capacity_block_offering_id = %[1]q
+  instance_platform          = "Linux/UNIX"
+
+  tags = {
+    Environment = "dev"
+  }
+}
+`, offeringID)
+}
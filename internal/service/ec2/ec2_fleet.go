@@ -119,6 +119,7 @@ func ResourceFleet() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"instances": fleetInstancesSchema(),
 			"launch_template_config": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -811,6 +812,13 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 			return sdkdiag.AppendErrorf(diags, "setting fleet_instance_set: %s", err)
 		}
 	}
+	instances, err := findFleetInstances(ctx, conn, fleet)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Fleet (%s) instances: %s", d.Id(), err)
+	}
+	if err := d.Set("instances", flattenFleetLaunchedInstances(instances)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instances: %s", err)
+	}
 	d.Set("fleet_state", fleet.FleetState)
 	d.Set("fulfilled_capacity", fleet.FulfilledCapacity)
 	d.Set("fulfilled_on_demand_capacity", fleet.FulfilledOnDemandCapacity)
@@ -936,6 +944,108 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+func fleetInstancesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrAvailabilityZone: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrInstanceID: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrInstanceType: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"private_ip": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrSubnetID: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// findFleetInstances returns the instances launched by an instant fleet.
+// Only instant fleets report the instances they launched.
+func findFleetInstances(ctx context.Context, conn *ec2.EC2, fleet *ec2.FleetData) ([]*ec2.Instance, error) {
+	if aws.StringValue(fleet.Type) != ec2.FleetTypeInstant {
+		return nil, nil
+	}
+
+	var instanceIDs []*string
+	for _, v := range fleet.Instances {
+		if v != nil {
+			instanceIDs = append(instanceIDs, v.InstanceIds...)
+		}
+	}
+
+	if len(instanceIDs) == 0 {
+		return nil, nil
+	}
+
+	output, err := FindInstances(ctx, conn, &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Preserve the order in which the fleet reports its instances.
+	instances := make(map[string]*ec2.Instance, len(output))
+	for _, v := range output {
+		instances[aws.StringValue(v.InstanceId)] = v
+	}
+
+	var apiObjects []*ec2.Instance
+	for _, v := range instanceIDs {
+		if v, ok := instances[aws.StringValue(v)]; ok {
+			apiObjects = append(apiObjects, v)
+		}
+	}
+
+	return apiObjects, nil
+}
+
+func flattenFleetLaunchedInstances(apiObjects []*ec2.Instance) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrInstanceID:   aws.StringValue(apiObject.InstanceId),
+			names.AttrInstanceType: aws.StringValue(apiObject.InstanceType),
+			"private_ip":           aws.StringValue(apiObject.PrivateIpAddress),
+			names.AttrSubnetID:     aws.StringValue(apiObject.SubnetId),
+		}
+
+		if v := apiObject.Placement; v != nil {
+			tfMap[names.AttrAvailabilityZone] = aws.StringValue(v.AvailabilityZone)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func resourceFleetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	if diff.Id() == "" { // New resource.
 		if diff.Get(names.AttrType).(string) != ec2.FleetTypeMaintain {
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2FleetDataSource_instant(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_fleet.test"
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetDataSourceConfig_instant(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fleet_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fleet_instance_set.#", resourceName, "fleet_instance_set.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fleet_state", resourceName, "fleet_state"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.instance_id", resourceName, "instances.0.instance_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.availability_zone", resourceName, "instances.0.availability_zone"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrType, "instant"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFleetDataSourceConfig_instant(rName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_type_instant(rName, "instant", true, acctest.Ct2), `
+data "aws_ec2_fleet" "test" {
+  fleet_id = aws_ec2_fleet.test.id
+}
+`)
+}
@@ -3046,6 +3046,10 @@ func TestAccEC2Fleet_type_instant(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.instance_ids.0"),
 					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.instance_type"),
 					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.lifecycle"),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "instances.0.instance_id", resourceName, "fleet_instance_set.0.instance_ids.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "instances.0.availability_zone"),
+					resource.TestCheckResourceAttrSet(resourceName, "instances.0.subnet_id"),
 				),
 			},
 			{
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -70,6 +71,11 @@ func ResourceHost() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			names.AttrForceDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"host_recovery": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -230,6 +236,40 @@ func resourceHostDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
+	host, err := FindHostByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	// Mac Dedicated Hosts have a minimum allocation period of 24 hours.
+	if v := hostReleaseTime(host); time.Now().Before(v) {
+		return sdkdiag.AppendErrorf(diags, "releasing EC2 Host (%s): Mac Dedicated Hosts can't be released until 24 hours after allocation (%s)", d.Id(), v.Format(time.RFC3339))
+	}
+
+	// The host is pending while a scrubbing workflow runs, e.g. after a Mac instance is stopped or terminated.
+	waitForAvailable := aws.StringValue(host.State) == ec2.AllocationStatePending
+
+	if d.Get(names.AttrForceDestroy).(bool) && len(host.Instances) > 0 {
+		for _, v := range host.Instances {
+			if err := detachInstanceFromHost(ctx, conn, aws.StringValue(v.InstanceId), d.Timeout(schema.TimeoutDelete)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "releasing EC2 Host (%s): %s", d.Id(), err)
+			}
+		}
+
+		waitForAvailable = true
+	}
+
+	if waitForAvailable {
+		if _, err := waitHostAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Host (%s) available: %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting EC2 Host: %s", d.Id())
 	output, err := conn.ReleaseHostsWithContext(ctx, &ec2.ReleaseHostsInput{
 		HostIds: aws.StringSlice([]string{d.Id()}),
@@ -253,3 +293,49 @@ func resourceHostDelete(ctx context.Context, d *schema.ResourceData, meta interf
 
 	return diags
 }
+
+const (
+	macHostMinimumAllocationPeriod = 24 * time.Hour
+)
+
+func isMacHost(host *ec2.Host) bool {
+	if host.HostProperties == nil {
+		return false
+	}
+
+	return strings.HasPrefix(aws.StringValue(host.HostProperties.InstanceFamily), "mac")
+}
+
+// hostReleaseTime returns the earliest time at which the Dedicated Host can be released.
+func hostReleaseTime(host *ec2.Host) time.Time {
+	if !isMacHost(host) {
+		return time.Time{}
+	}
+
+	return aws.TimeValue(host.AllocationTime).Add(macHostMinimumAllocationPeriod)
+}
+
+// detachInstanceFromHost stops an EC2 instance running on a Dedicated Host and removes its affinity with the host,
+// so that the host can be released without terminating the instance.
+func detachInstanceFromHost(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) error {
+	if err := stopInstance(ctx, conn, id, false, timeout); err != nil {
+		return err
+	}
+
+	input := &ec2.ModifyInstancePlacementInput{
+		Affinity:   aws.String(ec2.AffinityDefault),
+		InstanceId: aws.String(id),
+	}
+
+	_, err := conn.ModifyInstancePlacementWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("modifying EC2 Instance (%s) placement: %w", id, err)
+	}
+
+	return nil
+}
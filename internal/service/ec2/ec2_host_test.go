@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -20,6 +22,51 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestHostReleaseTime(t *testing.T) {
+	t.Parallel()
+
+	allocationTime := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		host     *ec2.Host
+		expected time.Time
+	}{
+		{
+			name: "no properties",
+			host: &ec2.Host{
+				AllocationTime: aws.Time(allocationTime),
+			},
+		},
+		{
+			name: "c5",
+			host: &ec2.Host{
+				AllocationTime: aws.Time(allocationTime),
+				HostProperties: &ec2.HostProperties{InstanceFamily: aws.String("c5")},
+			},
+		},
+		{
+			name: "mac2",
+			host: &ec2.Host{
+				AllocationTime: aws.Time(allocationTime),
+				HostProperties: &ec2.HostProperties{InstanceFamily: aws.String("mac2")},
+			},
+			expected: allocationTime.Add(24 * time.Hour),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := tfec2.HostReleaseTime(testCase.host), testCase.expected; !got.Equal(expected) {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
 func TestAccEC2Host_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var host ec2.Host
@@ -37,6 +84,7 @@ func TestAccEC2Host_basic(t *testing.T) {
 					testAccCheckHostExists(ctx, resourceName, &host),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ec2", regexache.MustCompile(`dedicated-host/.+`)),
 					resource.TestCheckResourceAttr(resourceName, "auto_placement", "on"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrForceDestroy, acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "host_recovery", "off"),
 					resource.TestCheckResourceAttr(resourceName, "instance_family", ""),
 					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "c5.large"),
@@ -49,6 +97,9 @@ func TestAccEC2Host_basic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrForceDestroy,
+				},
 			},
 		},
 	})
@@ -77,6 +128,37 @@ func TestAccEC2Host_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEC2Host_forceDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var host ec2.Host
+	var instance ec2.Instance
+	resourceName := "aws_ec2_host.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckHasDefaultVPCDefaultSubnets(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckHostDestroy(ctx),
+			testAccCheckHostInstanceStopped(ctx, &instance),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostConfig_forceDestroy(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostExists(ctx, resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, names.AttrForceDestroy, acctest.CtTrue),
+					testAccCheckHostRunInstance(ctx, &host, "data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64", &instance),
+					testAccCheckHostAvailable(ctx, &host),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Host_instanceFamily(t *testing.T) {
 	ctx := acctest.Context(t)
 	var host ec2.Host
@@ -107,6 +189,9 @@ func TestAccEC2Host_instanceFamily(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrForceDestroy,
+				},
 			},
 			{
 				Config: testAccHostConfig_instanceType(rName),
@@ -149,6 +234,9 @@ func TestAccEC2Host_tags(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrForceDestroy,
+				},
 			},
 			{
 				Config: testAccHostConfig_tags2(acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
@@ -218,6 +306,9 @@ func TestAccEC2Host_outpost(t *testing.T) {
 				ResourceName:      rName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrForceDestroy,
+				},
 			},
 		},
 	})
@@ -274,6 +365,71 @@ func testAccCheckHostDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckHostRunInstance launches an instance on the Dedicated Host outside of Terraform.
+func testAccCheckHostRunInstance(ctx context.Context, host *ec2.Host, amiDataSourceName string, v *ec2.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[amiDataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", amiDataSourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := conn.RunInstancesWithContext(ctx, &ec2.RunInstancesInput{
+			ImageId:      aws.String(ds.Primary.ID),
+			InstanceType: host.HostProperties.InstanceType,
+			MaxCount:     aws.Int64(1),
+			MinCount:     aws.Int64(1),
+			Placement: &ec2.Placement{
+				AvailabilityZone: host.AvailabilityZone,
+				HostId:           host.HostId,
+				Tenancy:          aws.String(ec2.TenancyHost),
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output.Instances[0]
+
+		return conn.WaitUntilInstanceRunningWithContext(ctx, &ec2.DescribeInstancesInput{
+			InstanceIds: []*string{v.InstanceId},
+		})
+	}
+}
+
+func testAccCheckHostAvailable(ctx context.Context, v *ec2.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.WaitHostAvailable(ctx, conn, aws.StringValue(v.HostId), 5*time.Minute)
+
+		return err
+	}
+}
+
+// testAccCheckHostInstanceStopped checks that the instance launched on the Dedicated Host was stopped, not terminated,
+// when the host was released and then terminates it.
+func testAccCheckHostInstanceStopped(ctx context.Context, v *ec2.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+		id := aws.StringValue(v.InstanceId)
+
+		output, err := tfec2.FindInstanceByID(ctx, conn, id)
+
+		if err != nil {
+			return err
+		}
+
+		if state := aws.StringValue(output.State.Name); state != ec2.InstanceStateNameStopped {
+			return fmt.Errorf("EC2 Instance (%s) state: got %s, expected %s", id, state, ec2.InstanceStateNameStopped)
+		}
+
+		return tfec2.TerminateInstance(ctx, conn, id, 10*time.Minute)
+	}
+}
+
 func testAccHostConfig_basic() string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), `
 resource "aws_ec2_host" "test" {
@@ -283,6 +439,16 @@ resource "aws_ec2_host" "test" {
 `)
 }
 
+func testAccHostConfig_forceDestroy() string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(), `
+resource "aws_ec2_host" "test" {
+  availability_zone = data.aws_availability_zones.available.names[1]
+  force_destroy     = true
+  instance_type     = "c5.large"
+}
+`)
+}
+
 func testAccHostConfig_instanceFamily(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_ec2_host" "test" {
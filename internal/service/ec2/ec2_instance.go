@@ -68,7 +68,6 @@ func ResourceInstance() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:         schema.TypeString,
-				ForceNew:     true,
 				Computed:     true,
 				Optional:     true,
 				AtLeastOneOf: []string{"ami", names.AttrLaunchTemplate},
@@ -825,6 +824,10 @@ func ResourceInstance() *schema.Resource {
 				ConflictsWith: []string{"user_data"},
 				ValidateFunc:  verify.ValidBase64String,
 			},
+			"replace_root_volume_on_ami_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"user_data_replace_on_change": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -897,6 +900,9 @@ func ResourceInstance() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			customdiff.ForceNewIf("ami", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return !diff.Get("replace_root_volume_on_ami_change").(bool)
+			}),
 			customdiff.ForceNewIf("user_data", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
@@ -970,7 +976,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	tagSpecifications := getTagSpecificationsIn(ctx, ec2.ResourceTypeInstance)
 
 	// block devices
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfigFromContext(ctx)
 	tagSpecifications = append(tagSpecifications,
 		tagSpecificationsFromKeyValue(
 			defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("volume_tags").(map[string]interface{}))),
@@ -1328,7 +1334,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 		}
 
-		defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+		defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfigFromContext(ctx)
 		ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 		tags := KeyValueTags(ctx, volumeTags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
@@ -1776,6 +1782,28 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("ami") && !d.IsNewResource() {
+		// The instance's root volume is replaced with a new volume restored from the AMI.
+		// The instance ID, network interfaces and instance store volumes are retained.
+		input := &ec2.CreateReplaceRootVolumeTaskInput{
+			DeleteReplacedRootVolume: aws.Bool(true),
+			ImageId:                  aws.String(d.Get("ami").(string)),
+			InstanceId:               aws.String(d.Id()),
+		}
+
+		output, err := conn.CreateReplaceRootVolumeTaskWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) AMI: replacing root volume: %s", d.Id(), err)
+		}
+
+		taskID := aws.StringValue(output.ReplaceRootVolumeTask.ReplaceRootVolumeTaskId)
+
+		if _, err := waitReplaceRootVolumeTaskSucceeded(ctx, conn, taskID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) AMI: waiting for root volume replacement task (%s): %s", d.Id(), taskID, err)
+		}
+	}
+
 	if d.HasChanges(names.AttrInstanceType, "user_data", "user_data_base64") && !d.IsNewResource() {
 		// For each argument change, we start and stop the instance
 		// to account for behaviors occurring outside terraform.
@@ -2322,7 +2350,7 @@ func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, m
 		return nil, err
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfigFromContext(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	for _, vol := range volResp.Volumes {
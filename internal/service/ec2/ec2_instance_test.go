@@ -2149,6 +2149,45 @@ func TestAccEC2Instance_rootBlockDeviceMismatch(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_replaceRootVolumeOnAMIChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	var instance1, instance2 awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_replaceRootVolumeOnAMIChange(rName, "minimal"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &instance1),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.minimal", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "replace_root_volume_on_ami_change", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace_root_volume_on_ami_change"},
+			},
+			// Switching should replace the root volume and not force a recreate
+			{
+				Config: testAccInstanceConfig_replaceRootVolumeOnAMIChange(rName, "standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &instance2),
+					testAccCheckInstanceNotRecreated(&instance1, &instance2),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.standard", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 // This test reproduces the bug here:
 //
 //	https://github.com/hashicorp/terraform/issues/1752
@@ -7729,6 +7768,58 @@ resource "aws_instance" "test" {
 `, rName)) //lintignore:AWSAT002
 }
 
+func testAccInstanceConfig_replaceRootVolumeOnAMIChange(rName, ami string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+data "aws_ami" "minimal" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn2-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+
+  filter {
+    name   = "architecture"
+    values = ["x86_64"]
+  }
+}
+
+data "aws_ami" "standard" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn2-ami-hvm-*-x86_64-gp2"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+
+resource "aws_instance" "test" {
+  ami                               = data.aws_ami.%[2]s.id
+  instance_type                     = "t2.micro"
+  subnet_id                         = aws_subnet.test.id
+  replace_root_volume_on_ami_change = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, ami))
+}
+
 func testAccInstanceConfig_forceNewAndTagsDrift(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
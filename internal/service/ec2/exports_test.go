@@ -65,6 +65,7 @@ var (
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone        = errCodeDefaultSubnetAlreadyExistsInAvailabilityZone
 	ErrCodeInvalidSpotDatafeedNotFound                         = errCodeInvalidSpotDatafeedNotFound
 	FindAvailabilityZonesV2                                    = findAvailabilityZonesV2
+	FindCapacityReservationByIDV2                              = findCapacityReservationByIDV2
 	FindCarrierGatewayByID                                     = findCarrierGatewayByID
 	FindClientVPNAuthorizationRuleByThreePartKey               = findClientVPNAuthorizationRuleByThreePartKey
 	FindClientVPNEndpointByID                                  = findClientVPNEndpointByID
@@ -125,7 +126,9 @@ var (
 	FindVPNGatewayVPCAttachmentByTwoPartKey                    = findVPNGatewayVPCAttachmentByTwoPartKey
 	FindVPNGatewayRoutePropagationExistsV2                     = findVPNGatewayRoutePropagationExists
 	FlattenNetworkInterfacePrivateIPAddresses                  = flattenNetworkInterfacePrivateIPAddresses
+	HostReleaseTime                                            = hostReleaseTime
 	IPAMServicePrincipal                                       = ipamServicePrincipal
+	ManagedPrefixListEntriesBatches                            = managedPrefixListEntriesBatches
 	NewAttributeFilterList                                     = newAttributeFilterList
 	NewAttributeFilterListV2                                   = newAttributeFilterListV2
 	NewCustomFilterList                                        = newCustomFilterList
@@ -133,8 +136,10 @@ var (
 	ProtocolForValue                                           = protocolForValue
 	StopInstance                                               = stopInstance
 	StopEBSVolumeAttachmentInstance                            = stopVolumeAttachmentInstance
+	TerminateInstance                                          = terminateInstance
 	UpdateTags                                                 = updateTags
 	UpdateTagsV2                                               = updateTagsV2
+	WaitHostAvailable                                          = waitHostAvailable
 )
 
 type (
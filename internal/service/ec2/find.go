@@ -2092,6 +2092,57 @@ func FindVolumeModificationByID(ctx context.Context, conn *ec2.EC2, id string) (
 	return output, nil
 }
 
+func findReplaceRootVolumeTasks(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeReplaceRootVolumeTasksInput) ([]*ec2.ReplaceRootVolumeTask, error) {
+	var output []*ec2.ReplaceRootVolumeTask
+
+	err := conn.DescribeReplaceRootVolumeTasksPagesWithContext(ctx, input, func(page *ec2.DescribeReplaceRootVolumeTasksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReplaceRootVolumeTasks {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findReplaceRootVolumeTaskByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.ReplaceRootVolumeTask, error) {
+	input := &ec2.DescribeReplaceRootVolumeTasksInput{
+		ReplaceRootVolumeTaskIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := findReplaceRootVolumeTasks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := tfresource.AssertSinglePtrResult(output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(task.ReplaceRootVolumeTaskId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return task, nil
+}
+
 func FindVPCAttribute(ctx context.Context, conn *ec2.EC2, vpcID string, attribute string) (bool, error) {
 	input := &ec2.DescribeVpcAttributeInput{
 		Attribute: aws.String(attribute),
@@ -1897,6 +1897,41 @@ func findIPAMPoolCIDRByPoolCIDRIDAndPoolID(ctx context.Context, conn *ec2.Client
 	return output, nil
 }
 
+func findByoipCIDRs(ctx context.Context, conn *ec2.Client, input *ec2.DescribeByoipCidrsInput) ([]awstypes.ByoipCidr, error) {
+	var output []awstypes.ByoipCidr
+
+	pages := ec2.NewDescribeByoipCidrsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ByoipCidrs...)
+	}
+
+	return output, nil
+}
+
+func findByoipCIDRByCIDR(ctx context.Context, conn *ec2.Client, cidrBlock string) (*awstypes.ByoipCidr, error) {
+	input := &ec2.DescribeByoipCidrsInput{
+		MaxResults: aws.Int32(100),
+	}
+
+	output, err := findByoipCIDRs(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output = tfslices.Filter(output, func(v awstypes.ByoipCidr) bool {
+		return aws.ToString(v.Cidr) == cidrBlock
+	})
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
 func findIPAMResourceDiscovery(ctx context.Context, conn *ec2.Client, input *ec2.DescribeIpamResourceDiscoveriesInput) (*awstypes.IpamResourceDiscovery, error) {
 	output, err := findIPAMResourceDiscoveries(ctx, conn, input)
 
@@ -3111,3 +3146,67 @@ func findTransitGatewayVPCAttachmentByID(ctx context.Context, conn *ec2.Client,
 
 	return output, nil
 }
+
+func findCapacityReservationV2(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityReservationsInput) (*awstypes.CapacityReservation, error) {
+	output, err := findCapacityReservationsV2(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCapacityReservationsV2(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityReservationsInput) ([]awstypes.CapacityReservation, error) {
+	var output []awstypes.CapacityReservation
+
+	pages := ec2.NewDescribeCapacityReservationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CapacityReservations...)
+	}
+
+	return output, nil
+}
+
+func findCapacityReservationByIDV2(ctx context.Context, conn *ec2.Client, id string) (*awstypes.CapacityReservation, error) {
+	input := &ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: []string{id},
+	}
+
+	output, err := findCapacityReservationV2(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/capacity-reservations-using.html#capacity-reservations-view.
+	switch state := output.State; state {
+	case awstypes.CapacityReservationStateCancelled, awstypes.CapacityReservationStateExpired:
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.CapacityReservationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
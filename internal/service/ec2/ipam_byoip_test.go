@@ -164,6 +164,48 @@ func TestAccIPAM_byoipIPv6(t *testing.T) {
 	})
 }
 
+// IPAM IPv4 BYOIP Tests
+func TestAccIPAM_byoipIPv4Advertise(t *testing.T) {
+	ctx := acctest.Context(t)
+	if os.Getenv("IPAM_BYOIP_IPV4_MESSAGE") == "" || os.Getenv("IPAM_BYOIP_IPV4_SIGNATURE") == "" || os.Getenv("IPAM_BYOIP_IPV4_PROVISIONED_CIDR") == "" {
+		t.Skip("Environment variable IPAM_BYOIP_IPV4_MESSAGE, IPAM_BYOIP_IPV4_SIGNATURE, or IPAM_BYOIP_IPV4_PROVISIONED_CIDR is not set")
+	}
+
+	m := os.Getenv("IPAM_BYOIP_IPV4_MESSAGE")
+	s := os.Getenv("IPAM_BYOIP_IPV4_SIGNATURE")
+	p := os.Getenv("IPAM_BYOIP_IPV4_PROVISIONED_CIDR")
+
+	resourceName := "aws_vpc_ipam_pool_cidr.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMPoolCIDRDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMBYOIPConfig_ipv4Advertise(p, m, s, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "advertise", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "cidr", p),
+				),
+			},
+			{
+				Config: testAccIPAMBYOIPConfig_ipv4Advertise(p, m, s, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "advertise", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccIPAMBYOIPConfig_ipv4Advertise(p, m, s, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "advertise", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func testAccIPAMConfig_ipv6BYOIPSkipExplicitCIDR(t *testing.T, ipv6CidrVPC string) func() (bool, error) {
 	return func() (bool, error) {
 		if ipv6CidrVPC != "" {
@@ -458,3 +500,33 @@ resource "aws_vpc_ipv6_cidr_block_association" "test" {
 }
 	`, cidr, msg, signature, vpcCidr)
 }
+
+func testAccIPAMBYOIPConfig_ipv4Advertise(cidr, msg, signature string, advertise bool) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.public_default_scope_id
+  locale         = data.aws_region.current.name
+  aws_service    = "ec2"
+}
+
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = %[1]q
+  advertise    = %[4]t
+
+  cidr_authorization_context {
+    message   = %[2]q
+    signature = %[3]q
+  }
+}
+`, cidr, msg, signature, advertise)
+}
@@ -30,6 +30,7 @@ func resourceIPAMPoolCIDR() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIPAMPoolCIDRCreate,
 		ReadWithoutTimeout:   resourceIPAMPoolCIDRRead,
+		UpdateWithoutTimeout: resourceIPAMPoolCIDRUpdate,
 		DeleteWithoutTimeout: resourceIPAMPoolCIDRDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -47,6 +48,11 @@ func resourceIPAMPoolCIDR() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			"advertise": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"cidr": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -147,6 +153,12 @@ func resourceIPAMPoolCIDRCreate(ctx context.Context, d *schema.ResourceData, met
 	// ipam_pool_cidr_id was not part of the initial feature release
 	d.SetId(IPAMPoolCIDRCreateResourceID(aws.ToString(ipamPoolCidr.Cidr), poolID))
 
+	if d.Get("advertise").(bool) {
+		if err := advertiseByoipCIDR(ctx, conn, aws.ToString(ipamPoolCidr.Cidr)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IPAM Pool CIDR (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceIPAMPoolCIDRRead(ctx, d, meta)...)
 }
 
@@ -176,9 +188,64 @@ func resourceIPAMPoolCIDRRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("ipam_pool_cidr_id", output.IpamPoolCidrId)
 	d.Set("ipam_pool_id", poolID)
 
+	// Only public CIDRs brought to AWS using BYOIP can be advertised.
+	// BYOIP CIDRs are only described for advertised CIDRs and CIDRs in public-scope pools.
+	advertisable := d.Get("advertise").(bool)
+
+	if !advertisable {
+		pool, err := findIPAMPoolByID(ctx, conn, poolID)
+
+		switch {
+		case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading IPAM Pool (%s): %s", poolID, err)
+		default:
+			advertisable = pool.IpamScopeType == awstypes.IpamScopeTypePublic
+		}
+	}
+
+	if advertisable {
+		byoipCIDR, err := findByoipCIDRByCIDR(ctx, conn, cidrBlock)
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("advertise", false)
+		case tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation):
+			// The advertisement state can't be read, so leave it unchanged.
+			log.Printf("[WARN] Unable to read IPAM Pool CIDR (%s) BYOIP CIDR: %s", d.Id(), err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading IPAM Pool CIDR (%s) BYOIP CIDR: %s", d.Id(), err)
+		default:
+			d.Set("advertise", byoipCIDR.State == awstypes.ByoipCidrStateAdvertised)
+		}
+	} else {
+		d.Set("advertise", false)
+	}
+
 	return diags
 }
 
+func resourceIPAMPoolCIDRUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChange("advertise") {
+		cidrBlock := d.Get("cidr").(string)
+
+		if d.Get("advertise").(bool) {
+			if err := advertiseByoipCIDR(ctx, conn, cidrBlock); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IPAM Pool CIDR (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := withdrawByoipCIDR(ctx, conn, cidrBlock); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IPAM Pool CIDR (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceIPAMPoolCIDRRead(ctx, d, meta)...)
+}
+
 func resourceIPAMPoolCIDRDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -189,6 +256,13 @@ func resourceIPAMPoolCIDRDelete(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	// Advertised CIDRs must be withdrawn before they can be deprovisioned.
+	if d.Get("advertise").(bool) {
+		if err := withdrawByoipCIDR(ctx, conn, cidrBlock); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting IPAM Pool CIDR (%s): %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting IPAM Pool CIDR: %s", d.Id())
 	_, err = conn.DeprovisionIpamPoolCidr(ctx, &ec2.DeprovisionIpamPoolCidrInput{
 		Cidr:       aws.String(cidrBlock),
@@ -230,6 +304,34 @@ func IPAMPoolCIDRParseResourceID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+func advertiseByoipCIDR(ctx context.Context, conn *ec2.Client, cidrBlock string) error {
+	input := &ec2.AdvertiseByoipCidrInput{
+		Cidr: aws.String(cidrBlock),
+	}
+
+	_, err := conn.AdvertiseByoipCidr(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("advertising BYOIP CIDR (%s): %w", cidrBlock, err)
+	}
+
+	return nil
+}
+
+func withdrawByoipCIDR(ctx context.Context, conn *ec2.Client, cidrBlock string) error {
+	input := &ec2.WithdrawByoipCidrInput{
+		Cidr: aws.String(cidrBlock),
+	}
+
+	_, err := conn.WithdrawByoipCidr(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("withdrawing BYOIP CIDR (%s): %w", cidrBlock, err)
+	}
+
+	return nil
+}
+
 func expandIPAMCIDRAuthorizationContext(tfMap map[string]interface{}) *awstypes.IpamCidrAuthorizationContext {
 	if tfMap == nil {
 		return nil
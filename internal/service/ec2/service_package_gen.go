@@ -27,6 +27,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newCapacityBlockReservationResource,
+			Name:    "Capacity Block Reservation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 		{
 			Factory: newEBSFastSnapshotRestoreResource,
 			Name:    "EBS Fast Snapshot Restore",
@@ -133,6 +140,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCoIPPools,
 			TypeName: "aws_ec2_coip_pools",
 		},
+		{
+			Factory:  DataSourceFleet,
+			TypeName: "aws_ec2_fleet",
+		},
 		{
 			Factory:  DataSourceHost,
 			TypeName: "aws_ec2_host",
@@ -213,6 +224,18 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSpotPrice,
 			TypeName: "aws_ec2_spot_price",
 		},
+		{
+			Factory:  dataSourceTrafficMirrorFilter,
+			TypeName: "aws_ec2_traffic_mirror_filter",
+			Name:     "Traffic Mirror Filter",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  dataSourceTrafficMirrorTarget,
+			TypeName: "aws_ec2_traffic_mirror_target",
+			Name:     "Traffic Mirror Target",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  dataSourceTransitGateway,
 			TypeName: "aws_ec2_transit_gateway",
@@ -664,6 +687,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceManagedPrefixListEntries,
+			TypeName: "aws_ec2_managed_prefix_list_entries",
+			Name:     "Managed Prefix List Entries",
+		},
 		{
 			Factory:  ResourceManagedPrefixListEntry,
 			TypeName: "aws_ec2_managed_prefix_list_entry",
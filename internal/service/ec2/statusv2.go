@@ -772,3 +772,19 @@ func statusTransitGatewayVPCAttachmentState(ctx context.Context, conn *ec2.Clien
 		return output, string(output.State), nil
 	}
 }
+
+func statusCapacityReservationStateV2(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCapacityReservationByIDV2(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -124,8 +125,8 @@ func ResourceFlowLog() *schema.Resource {
 			"max_aggregation_interval": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
-				Default:      600,
 				ValidateFunc: validation.IntInSlice([]int{60, 600}),
 			},
 			names.AttrSubnetID: {
@@ -162,7 +163,10 @@ func ResourceFlowLog() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceFlowLogCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -244,6 +248,8 @@ func resourceLogFlowCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	if v, ok := d.GetOk("max_aggregation_interval"); ok {
 		input.MaxAggregationInterval = aws.Int64(int64(v.(int)))
+	} else {
+		input.MaxAggregationInterval = aws.Int64(int64(flowLogDefaultMaxAggregationInterval(resourceType)))
 	}
 
 	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, iamPropagationTimeout, func() (interface{}, error) {
@@ -395,3 +401,34 @@ func flattenDestinationOptionsResponse(apiObject *ec2.DestinationOptionsResponse
 
 	return tfMap
 }
+
+func resourceFlowLogCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" {
+		return nil
+	}
+
+	config := diff.GetRawConfig()
+
+	if config.GetAttr(names.AttrTransitGatewayID).IsNull() && config.GetAttr(names.AttrTransitGatewayAttachmentID).IsNull() {
+		return nil
+	}
+
+	// Transit Gateway and Transit Gateway Attachment flow logs only support a 60 second aggregation interval.
+	if v := config.GetAttr("max_aggregation_interval"); v.IsKnown() && !v.IsNull() {
+		if v, _ := v.AsBigFloat().Int64(); v != 60 {
+			return fmt.Errorf("max_aggregation_interval must be 60 when transit_gateway_id or transit_gateway_attachment_id is specified, got: %d", v)
+		}
+	}
+
+	return nil
+}
+
+// flowLogDefaultMaxAggregationInterval returns the aggregation interval, in seconds, used when none is configured.
+func flowLogDefaultMaxAggregationInterval(resourceType string) int {
+	switch resourceType {
+	case ec2.FlowLogsResourceTypeTransitGateway, ec2.FlowLogsResourceTypeTransitGatewayAttachment:
+		return 60
+	default:
+		return 600
+	}
+}
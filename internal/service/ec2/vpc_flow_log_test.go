@@ -163,6 +163,24 @@ func TestAccVPCFlowLog_transitGatewayID(t *testing.T) {
 	})
 }
 
+func TestAccVPCFlowLog_transitGatewayIDInvalidMaxAggregationInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCFlowLogConfig_transitGatewayIDMaxAggregationInterval(rName, 600),
+				ExpectError: regexache.MustCompile(`max_aggregation_interval must be 60`),
+			},
+		},
+	})
+}
+
 func TestAccVPCFlowLog_transitGatewayAttachmentID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowLog ec2.FlowLog
@@ -1151,6 +1169,26 @@ resource "aws_flow_log" "test" {
 `, rName))
 }
 
+func testAccVPCFlowLogConfig_transitGatewayIDMaxAggregationInterval(rName string, maxAggregationInterval int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_flow_log" "test" {
+  log_destination          = aws_cloudwatch_log_group.test.arn
+  max_aggregation_interval = %[2]d
+  transit_gateway_id       = aws_ec2_transit_gateway.test.id
+}
+`, rName, maxAggregationInterval)
+}
+
 func testAccVPCFlowLogConfig_transitGatewayAttachmentID(rName string) string {
 	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -1201,7 +1239,6 @@ resource "aws_cloudwatch_log_group" "test" {
 resource "aws_flow_log" "test" {
   iam_role_arn                  = aws_iam_role.test.arn
   log_group_name                = aws_cloudwatch_log_group.test.name
-  max_aggregation_interval      = 60
   transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
 
   tags = {
@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// ModifyManagedPrefixList accepts at most 100 entries to add and 100 entries to remove per request.
+	managedPrefixListModifyEntriesBatchSize = 100
+)
+
+// @SDKResource("aws_ec2_managed_prefix_list_entries", name="Managed Prefix List Entries")
+func resourceManagedPrefixListEntries() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceManagedPrefixListEntriesCreate,
+		ReadWithoutTimeout:   resourceManagedPrefixListEntriesRead,
+		UpdateWithoutTimeout: resourceManagedPrefixListEntriesUpdate,
+		DeleteWithoutTimeout: resourceManagedPrefixListEntriesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceManagedPrefixListEntriesImport,
+		},
+
+		CustomizeDiff: customdiff.ComputedIf(names.AttrVersion, func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+			return diff.HasChange("entry")
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"entry": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceManagedPrefixListEntriesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	plID := d.Get("prefix_list_id").(string)
+
+	if err := syncManagedPrefixListEntries(ctx, conn, plID, d.Get("entry").(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Managed Prefix List Entries (%s): %s", plID, err)
+	}
+
+	d.SetId(plID)
+
+	return append(diags, resourceManagedPrefixListEntriesRead(ctx, d, meta)...)
+}
+
+func resourceManagedPrefixListEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	pl, err := FindManagedPrefixListByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Managed Prefix List %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s): %s", d.Id(), err)
+	}
+
+	prefixListEntries, err := FindManagedPrefixListEntriesByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s) Entries: %s", d.Id(), err)
+	}
+
+	if err := d.Set("entry", flattenPrefixListEntries(prefixListEntries)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entry: %s", err)
+	}
+	d.Set("prefix_list_id", pl.PrefixListId)
+	d.Set(names.AttrVersion, pl.Version)
+
+	return diags
+}
+
+func resourceManagedPrefixListEntriesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if d.HasChange("entry") {
+		if err := syncManagedPrefixListEntries(ctx, conn, d.Id(), d.Get("entry").(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List Entries (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceManagedPrefixListEntriesRead(ctx, d, meta)...)
+}
+
+func resourceManagedPrefixListEntriesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[INFO] Deleting EC2 Managed Prefix List Entries: %s", d.Id())
+	err := syncManagedPrefixListEntries(ctx, conn, d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Managed Prefix List Entries (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceManagedPrefixListEntriesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("prefix_list_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncManagedPrefixListEntries reconciles the prefix list's entries with the desired set.
+// Entries are added and removed in the same ModifyManagedPrefixList call whenever possible so that unchanged entries
+// are never missing from the prefix list.
+func syncManagedPrefixListEntries(ctx context.Context, conn *ec2.EC2, plID string, tfList []interface{}, timeout time.Duration) error {
+	mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	want := make(map[string]*ec2.AddPrefixListEntry)
+	for _, v := range expandAddPrefixListEntries(tfList) {
+		want[aws.StringValue(v.Cidr)] = v
+	}
+
+	pl, err := FindManagedPrefixListByID(ctx, conn, plID)
+
+	if err != nil {
+		return err
+	}
+
+	prefixListEntries, err := FindManagedPrefixListEntriesByID(ctx, conn, plID)
+
+	if err != nil {
+		return err
+	}
+
+	var add, update []*ec2.AddPrefixListEntry
+	var remove []*ec2.RemovePrefixListEntry
+	have := make(map[string]struct{})
+
+	for _, v := range prefixListEntries {
+		cidr := aws.StringValue(v.Cidr)
+		have[cidr] = struct{}{}
+
+		if w, ok := want[cidr]; !ok {
+			remove = append(remove, &ec2.RemovePrefixListEntry{Cidr: v.Cidr})
+		} else if aws.StringValue(w.Description) != aws.StringValue(v.Description) {
+			// Adding an existing entry updates its description in place.
+			update = append(update, w)
+		}
+	}
+
+	for cidr, v := range want {
+		if _, ok := have[cidr]; !ok {
+			add = append(add, v)
+		}
+	}
+
+	addBatches, removeBatches := managedPrefixListEntriesBatches(add, update, remove, len(prefixListEntries), pl.MaxEntries)
+
+	for i := range addBatches {
+		if err := modifyManagedPrefixListEntries(ctx, conn, plID, addBatches[i], removeBatches[i], timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// managedPrefixListEntriesBatches splits entry changes into the AddEntries and RemoveEntries of successive ModifyManagedPrefixList calls.
+// Each call adds and removes at most managedPrefixListModifyEntriesBatchSize entries. New entries are only added once
+// enough entries have been removed for the prefix list to stay within its maximum number of entries. Entries whose
+// descriptions are updated don't change the number of entries.
+func managedPrefixListEntriesBatches(add, update []*ec2.AddPrefixListEntry, remove []*ec2.RemovePrefixListEntry, count int, maxEntries *int64) ([][]*ec2.AddPrefixListEntry, [][]*ec2.RemovePrefixListEntry) {
+	var addBatches [][]*ec2.AddPrefixListEntry
+	var removeBatches [][]*ec2.RemovePrefixListEntry
+
+	for len(add) > 0 || len(update) > 0 || len(remove) > 0 {
+		n := min(len(remove), managedPrefixListModifyEntriesBatchSize)
+		removeBatch := remove[:n]
+		remove = remove[n:]
+		count -= n
+
+		n = min(len(add), managedPrefixListModifyEntriesBatchSize)
+		if maxEntries != nil {
+			n = max(min(n, int(aws.Int64Value(maxEntries))-count), 0)
+		}
+		if n == 0 && len(removeBatch) == 0 && len(update) == 0 {
+			// There's no room for the remaining new entries. Add them anyway and let the API report the error.
+			n = min(len(add), managedPrefixListModifyEntriesBatchSize)
+		}
+		addBatch := add[:n]
+		add = add[n:]
+		count += n
+
+		n = min(len(update), managedPrefixListModifyEntriesBatchSize-len(addBatch))
+		addBatch = append(addBatch[:len(addBatch):len(addBatch)], update[:n]...)
+		update = update[n:]
+
+		addBatches = append(addBatches, addBatch)
+		removeBatches = append(removeBatches, removeBatch)
+	}
+
+	return addBatches, removeBatches
+}
+
+func modifyManagedPrefixListEntries(ctx context.Context, conn *ec2.EC2, plID string, add []*ec2.AddPrefixListEntry, remove []*ec2.RemovePrefixListEntry, timeout time.Duration) error {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		pl, err := FindManagedPrefixListByID(ctx, conn, plID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		input := &ec2.ModifyManagedPrefixListInput{
+			CurrentVersion: pl.Version,
+			PrefixListId:   aws.String(plID),
+		}
+
+		if len(add) > 0 {
+			input.AddEntries = add
+		}
+
+		if len(remove) > 0 {
+			input.RemoveEntries = remove
+		}
+
+		return conn.ModifyManagedPrefixListWithContext(ctx, input)
+	}, errCodeIncorrectState, errCodePrefixListVersionMismatch)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := WaitManagedPrefixListModified(ctx, conn, plID); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestManagedPrefixListEntriesBatches(t *testing.T) {
+	t.Parallel()
+
+	addEntries := func(start, n int) []*ec2.AddPrefixListEntry {
+		var entries []*ec2.AddPrefixListEntry
+		for i := start; i < start+n; i++ {
+			entries = append(entries, &ec2.AddPrefixListEntry{Cidr: aws.String(fmt.Sprintf("10.0.%d.0/24", i))})
+		}
+		return entries
+	}
+	removeEntries := func(start, n int) []*ec2.RemovePrefixListEntry {
+		var entries []*ec2.RemovePrefixListEntry
+		for i := start; i < start+n; i++ {
+			entries = append(entries, &ec2.RemovePrefixListEntry{Cidr: aws.String(fmt.Sprintf("10.1.%d.0/24", i))})
+		}
+		return entries
+	}
+
+	testCases := map[string]struct {
+		add, update         []*ec2.AddPrefixListEntry
+		remove              []*ec2.RemovePrefixListEntry
+		count               int
+		maxEntries          int64
+		expectedAddSizes    []int
+		expectedRemoveSizes []int
+	}{
+		"description update only": {
+			update:              addEntries(0, 1),
+			count:               1,
+			maxEntries:          1,
+			expectedAddSizes:    []int{1},
+			expectedRemoveSizes: []int{0},
+		},
+		"replace at max entries": {
+			add:                 addEntries(0, 2),
+			update:              addEntries(2, 1),
+			remove:              removeEntries(0, 2),
+			count:               3,
+			maxEntries:          3,
+			expectedAddSizes:    []int{3},
+			expectedRemoveSizes: []int{2},
+		},
+		"more new entries than max entries": {
+			add:                 addEntries(0, 3),
+			remove:              removeEntries(0, 1),
+			count:               3,
+			maxEntries:          3,
+			expectedAddSizes:    []int{1, 2},
+			expectedRemoveSizes: []int{1, 0},
+		},
+		"batch size": {
+			add:                 addEntries(0, 150),
+			remove:              removeEntries(0, 120),
+			count:               120,
+			maxEntries:          200,
+			expectedAddSizes:    []int{100, 50},
+			expectedRemoveSizes: []int{100, 20},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			addBatches, removeBatches := tfec2.ManagedPrefixListEntriesBatches(testCase.add, testCase.update, testCase.remove, testCase.count, aws.Int64(testCase.maxEntries))
+
+			var addSizes, removeSizes []int
+			for i := range addBatches {
+				addSizes = append(addSizes, len(addBatches[i]))
+				removeSizes = append(removeSizes, len(removeBatches[i]))
+			}
+
+			if got, expected := fmt.Sprint(addSizes), fmt.Sprint(testCase.expectedAddSizes); got != expected {
+				t.Errorf("add batch sizes: got %s, expected %s", got, expected)
+			}
+			if got, expected := fmt.Sprint(removeSizes), fmt.Sprint(testCase.expectedRemoveSizes); got != expected {
+				t.Errorf("remove batch sizes: got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestAccVPCManagedPrefixListEntries_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_entries.test"
+	plResourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// More entries than a single ModifyManagedPrefixList call accepts.
+				Config: testAccVPCManagedPrefixListEntriesConfig_basic(rName, 0, 150, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "prefix_list_id", plResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "150"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":                "10.0.0.0/24",
+						names.AttrDescription: "first",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_basic(rName, 100, 120, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "120"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":                "10.0.100.0/24",
+						names.AttrDescription: "second",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":                "10.0.219.0/24",
+						names.AttrDescription: "second",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCManagedPrefixListEntriesConfig_basic(rName string, start, count int, description string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 250
+  name           = %[1]q
+}
+
+resource "aws_ec2_managed_prefix_list_entries" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  dynamic "entry" {
+    for_each = range(%[2]d, %[2]d + %[3]d)
+
+    content {
+      cidr        = "10.0.${entry.value}.0/24"
+      description = %[4]q
+    }
+  }
+}
+`, rName, start, count, description)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCTrafficMirrorFilterDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_traffic_mirror_filter.test"
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorFilter(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorFilterDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_services.#", resourceName, "network_services.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, "traffic_mirror_filter_id", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCTrafficMirrorFilterDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_traffic_mirror_filter.test"
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorFilter(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorFilterDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, "traffic_mirror_filter_id", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCTrafficMirrorFilterDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  description = %[1]q
+
+  network_services = ["amazon-dns"]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCTrafficMirrorFilterDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCTrafficMirrorFilterDataSourceConfig_base(rName), `
+data "aws_ec2_traffic_mirror_filter" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+}
+`)
+}
+
+func testAccVPCTrafficMirrorFilterDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccVPCTrafficMirrorFilterDataSourceConfig_base(rName), `
+data "aws_ec2_traffic_mirror_filter" "test" {
+  filter {
+    name   = "description"
+    values = [aws_ec2_traffic_mirror_filter.test.description]
+  }
+
+  filter {
+    name   = "traffic-mirror-filter-id"
+    values = [aws_ec2_traffic_mirror_filter.test.id]
+  }
+}
+`)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_traffic_mirror_target", name="Traffic Mirror Target")
+// @Tags
+func dataSourceTrafficMirrorTarget() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTrafficMirrorTargetRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrFilter: customFiltersSchema(),
+			"gateway_load_balancer_endpoint_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrNetworkInterfaceID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_load_balancer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrOwnerID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"traffic_mirror_target_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTrafficMirrorTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.DescribeTrafficMirrorTargetsInput{
+		Filters: newCustomFilterList(d.Get(names.AttrFilter).(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("traffic_mirror_target_id"); ok {
+		input.TrafficMirrorTargetIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	target, err := FindTrafficMirrorTarget(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Traffic Mirror Target", err))
+	}
+
+	d.SetId(aws.StringValue(target.TrafficMirrorTargetId))
+
+	ownerID := aws.StringValue(target.OwnerId)
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: ownerID,
+		Resource:  fmt.Sprintf("traffic-mirror-target/%s", d.Id()),
+	}.String()
+	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrDescription, target.Description)
+	d.Set("gateway_load_balancer_endpoint_id", target.GatewayLoadBalancerEndpointId)
+	d.Set(names.AttrNetworkInterfaceID, target.NetworkInterfaceId)
+	d.Set("network_load_balancer_arn", target.NetworkLoadBalancerArn)
+	d.Set(names.AttrOwnerID, ownerID)
+	d.Set("traffic_mirror_target_id", target.TrafficMirrorTargetId)
+
+	setTagsOut(ctx, target.Tags)
+
+	return diags
+}
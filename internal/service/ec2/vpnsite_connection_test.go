@@ -434,7 +434,7 @@ func TestAccSiteVPNConnection_tunnel1InsideCIDR(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
 	resourceName := "aws_vpn_connection.test"
-	var vpn awstypes.VpnConnection
+	var vpn1, vpn2 awstypes.VpnConnection
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
@@ -445,11 +445,20 @@ func TestAccSiteVPNConnection_tunnel1InsideCIDR(t *testing.T) {
 			{
 				Config: testAccSiteVPNConnectionConfig_tunnel1InsideCIDR(rName, rBgpAsn, "169.254.8.0/30", "169.254.9.0/30"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccVPNConnectionExists(ctx, resourceName, &vpn),
+					testAccVPNConnectionExists(ctx, resourceName, &vpn1),
 					resource.TestCheckResourceAttr(resourceName, "tunnel1_inside_cidr", "169.254.8.0/30"),
 					resource.TestCheckResourceAttr(resourceName, "tunnel2_inside_cidr", "169.254.9.0/30"),
 				),
 			},
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnel1InsideCIDR(rName, rBgpAsn, "169.254.10.0/30", "169.254.11.0/30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn2),
+					testAccCheckVPNConnectionNotRecreated(&vpn1, &vpn2),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_inside_cidr", "169.254.10.0/30"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_inside_cidr", "169.254.11.0/30"),
+				),
+			},
 			// NOTE: Import does not currently have access to the Terraform configuration,
 			//       so proper tunnel ordering is not guaranteed on import. The import
 			//       identifier could potentially be updated to accept optional tunnel
@@ -711,7 +720,7 @@ func TestAccSiteVPNConnection_tunnelOptionsLesser(t *testing.T) {
 		startupAction:                "start",
 	}
 
-	// inside_cidr can't be updated in-place.
+	// Swapping inside_cidr between tunnels in-place would conflict.
 	tunnel1Updated := tunnel2
 	tunnel1Updated.tunnelCidr = tunnel1.tunnelCidr
 
@@ -647,6 +647,25 @@ func WaitVolumeModificationComplete(ctx context.Context, conn *ec2.EC2, id strin
 	return nil, err
 }
 
+func waitReplaceRootVolumeTaskSucceeded(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.ReplaceRootVolumeTask, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ec2.ReplaceRootVolumeTaskStatePending, ec2.ReplaceRootVolumeTaskStateInProgress},
+		Target:     []string{ec2.ReplaceRootVolumeTaskStateSucceeded},
+		Refresh:    statusReplaceRootVolumeTask(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.ReplaceRootVolumeTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	vpcCreatedTimeout = 10 * time.Minute
 	vpcDeletedTimeout = 5 * time.Minute
@@ -995,6 +1014,24 @@ func WaitHostUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout time
 	return nil, err
 }
 
+func waitHostAvailable(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Host, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.AllocationStatePending},
+		Target:  []string{ec2.AllocationStateAvailable},
+		Timeout: timeout,
+		Refresh: StatusHostState(ctx, conn, id),
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.Host); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitHostDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Host, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{ec2.AllocationStateAvailable},
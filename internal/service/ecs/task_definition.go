@@ -283,6 +283,11 @@ func ResourceTaskDefinition() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			"track_latest_ignore_external_changes": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
 			"volume": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -616,6 +621,15 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set(names.AttrFamily, taskDefinition.Family)
 	d.Set("revision", taskDefinition.Revision)
 	d.Set("track_latest", d.Get("track_latest"))
+	d.Set("track_latest_ignore_external_changes", d.Get("track_latest_ignore_external_changes"))
+
+	// When tracking revisions registered outside Terraform (e.g. by CI), keep the Terraform-defined
+	// task definition in state so that only changes to the configuration cause a new revision to be registered.
+	if d.Get("track_latest").(bool) && d.Get("track_latest_ignore_external_changes").(bool) && d.Get("container_definitions").(string) != "" {
+		setTagsOut(ctx, out.Tags)
+
+		return diags
+	}
 
 	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
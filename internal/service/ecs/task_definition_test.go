@@ -1195,6 +1195,46 @@ func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_trackLatestIgnoreExternalChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_trackLatestIgnoreExternalChanges(rName, 128),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "track_latest", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "track_latest_ignore_external_changes", acctest.CtTrue),
+					// Simulate CI registering a new revision outside Terraform.
+					testAccCheckTaskDefinitionRegisterExternalRevision(ctx, &def, "jenkins:lts"),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_trackLatestIgnoreExternalChanges(rName, 128),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "revision", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_trackLatestIgnoreExternalChanges(rName, 256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", acctest.Ct3),
+				),
+			},
+		},
+	})
+}
+
 func testAccTaskDefinitionConfig_proxyConfiguration(rName string, containerName string, proxyType string,
 	ignoredUid string, ignoredGid string, appPorts string, proxyIngressPort string, proxyEgressPort string,
 	egressIgnoredPorts string, egressIgnoredIPs string) string {
@@ -1362,6 +1402,26 @@ func testAccCheckTaskDefinitionDestroy(ctx context.Context) resource.TestCheckFu
 	}
 }
 
+func testAccCheckTaskDefinitionRegisterExternalRevision(ctx context.Context, def *ecs.TaskDefinition, image string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
+
+		containerDefinitions := make([]*ecs.ContainerDefinition, 0, len(def.ContainerDefinitions))
+		for _, v := range def.ContainerDefinitions {
+			cd := *v
+			cd.Image = aws.String(image)
+			containerDefinitions = append(containerDefinitions, &cd)
+		}
+
+		_, err := conn.RegisterTaskDefinitionWithContext(ctx, &ecs.RegisterTaskDefinitionInput{
+			ContainerDefinitions: containerDefinitions,
+			Family:               def.Family,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckTaskDefinitionExists(ctx context.Context, name string, def *ecs.TaskDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -3009,3 +3069,27 @@ TASK_DEFINITION
 }
 `, rName)
 }
+
+func testAccTaskDefinitionConfig_trackLatestIgnoreExternalChanges(rName string, memory int) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family                               = %[1]q
+  track_latest                         = true
+  track_latest_ignore_external_changes = true
+
+  container_definitions = <<TASK_DEFINITION
+[
+	{
+		"cpu": 10,
+		"command": ["sleep", "10"],
+		"entryPoint": ["/"],
+		"essential": true,
+		"image": "jenkins",
+		"memory": %[2]d,
+		"name": "jenkins"
+	}
+]
+TASK_DEFINITION
+}
+`, rName, memory)
+}
@@ -0,0 +1,851 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	gversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_greengrassv2_component_version", name="Component Version")
+// @Tags(identifierAttribute="arn")
+func ResourceComponentVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentVersionCreate,
+		ReadWithoutTimeout:   resourceComponentVersionRead,
+		UpdateWithoutTimeout: resourceComponentVersionUpdate,
+		DeleteWithoutTimeout: resourceComponentVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inline_recipe": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"inline_recipe", "lambda_function"},
+			},
+			"lambda_function": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"inline_recipe", "lambda_function"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_dependency": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"component_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"dependency_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.ComponentDependencyType_Values(), false),
+									},
+									"version_requirement": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"component_lambda_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"environment_variables": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"event_source": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"topic": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												names.AttrType: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.LambdaEventSourceType_Values(), false),
+												},
+											},
+										},
+									},
+									"exec_args": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"input_payload_encoding_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.LambdaInputPayloadEncodingType_Values(), false),
+									},
+									"linux_process_params": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"container_params": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"device": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"add_group_owner": {
+																			Type:     schema.TypeBool,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		names.AttrPath: {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																		"permission": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ForceNew:     true,
+																			ValidateFunc: validation.StringInSlice(greengrassv2.LambdaFilesystemPermission_Values(), false),
+																		},
+																	},
+																},
+															},
+															"memory_size_in_kb": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																ForceNew: true,
+															},
+															"mount_ro_sysfs": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+															"volume": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"add_group_owner": {
+																			Type:     schema.TypeBool,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"destination_path": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																		"permission": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ForceNew:     true,
+																			ValidateFunc: validation.StringInSlice(greengrassv2.LambdaFilesystemPermission_Values(), false),
+																		},
+																		"source_path": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"isolation_mode": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.LambdaIsolationMode_Values(), false),
+												},
+											},
+										},
+									},
+									"max_idle_time_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_instances_count": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_queue_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"pinned": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  true,
+									},
+									"status_timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"component_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"component_platform": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"component_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"lambda_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"latest_patch_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platforms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceComponentVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn(ctx)
+
+	input := &greengrassv2.CreateComponentVersionInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("inline_recipe"); ok {
+		input.InlineRecipe = []byte(v.(string))
+	}
+
+	if v, ok := d.GetOk("lambda_function"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LambdaFunction = expandLambdaFunctionRecipeSource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateComponentVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Greengrass V2 Component Version: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if _, err := waitComponentVersionDeployable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Greengrass V2 Component Version (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceComponentVersionRead(ctx, d, meta)...)
+}
+
+func resourceComponentVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn(ctx)
+
+	output, err := FindComponentVersionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Component Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	componentARN := componentARNFromVersionARN(d.Id())
+	version := aws.StringValue(output.ComponentVersion)
+	latestPatchVersion, err := findLatestPatchVersion(ctx, conn, componentARN, version)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component (%s) versions: %s", componentARN, err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set("component_arn", componentARN)
+	d.Set("component_name", output.ComponentName)
+	d.Set("component_version", version)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("latest_patch_version", latestPatchVersion)
+	if err := d.Set("platforms", flattenComponentPlatforms(output.Platforms)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting platforms: %s", err)
+	}
+	d.Set("publisher", output.Publisher)
+	if output.Status != nil {
+		d.Set(names.AttrStatus, output.Status.ComponentState)
+	} else {
+		d.Set(names.AttrStatus, nil)
+	}
+
+	return diags
+}
+
+func resourceComponentVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceComponentVersionRead(ctx, d, meta)...)
+}
+
+func resourceComponentVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting Greengrass V2 Component Version: %s", d.Id())
+	_, err := conn.DeleteComponentWithContext(ctx, &greengrassv2.DeleteComponentInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindComponentVersionByARN(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) (*greengrassv2.DescribeComponentOutput, error) {
+	input := &greengrassv2.DescribeComponentInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeComponentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findComponentVersionsByARN(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) ([]*greengrassv2.ComponentVersionListItem, error) {
+	input := &greengrassv2.ListComponentVersionsInput{
+		Arn: aws.String(arn),
+	}
+	var output []*greengrassv2.ComponentVersionListItem
+
+	err := conn.ListComponentVersionsPagesWithContext(ctx, input, func(page *greengrassv2.ListComponentVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ComponentVersions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findLatestPatchVersion returns the highest version of the component that shares
+// the specified version's major and minor version numbers.
+func findLatestPatchVersion(ctx context.Context, conn *greengrassv2.GreengrassV2, componentARN, version string) (string, error) {
+	v, err := gversion.NewVersion(version)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing version (%s): %w", version, err)
+	}
+
+	versions, err := findComponentVersionsByARN(ctx, conn, componentARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	latest := v
+	for _, item := range versions {
+		w, err := gversion.NewVersion(aws.StringValue(item.ComponentVersion))
+
+		if err != nil {
+			continue
+		}
+
+		if s1, s2 := v.Segments(), w.Segments(); s1[0] == s2[0] && s1[1] == s2[1] && w.GreaterThan(latest) {
+			latest = w
+		}
+	}
+
+	return latest.Original(), nil
+}
+
+func statusComponentVersion(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindComponentVersionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.ComponentState), nil
+	}
+}
+
+func waitComponentVersionDeployable(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string, timeout time.Duration) (*greengrassv2.DescribeComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{greengrassv2.CloudComponentStateRequested, greengrassv2.CloudComponentStateInitiated},
+		Target:  []string{greengrassv2.CloudComponentStateDeployable},
+		Refresh: statusComponentVersion(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*greengrassv2.DescribeComponentOutput); ok {
+		if aws.StringValue(output.Status.ComponentState) == greengrassv2.CloudComponentStateFailed {
+			var errs []error
+			for k, v := range output.Status.Errors {
+				errs = append(errs, fmt.Errorf("%s: %s", k, aws.StringValue(v)))
+			}
+			if len(errs) == 0 {
+				errs = append(errs, errors.New(aws.StringValue(output.Status.Message)))
+			}
+			tfresource.SetLastError(err, errors.Join(errs...))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// componentARNFromVersionARN returns the ARN of the component to which a component version belongs.
+// Component version ARNs have the form arn:${Partition}:greengrass:${Region}:${Account}:components:${ComponentName}:versions:${ComponentVersion}.
+func componentARNFromVersionARN(arn string) string {
+	componentARN, _, _ := strings.Cut(arn, ":versions:")
+
+	return componentARN
+}
+
+func expandLambdaFunctionRecipeSource(tfMap map[string]interface{}) *greengrassv2.LambdaFunctionRecipeSource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaFunctionRecipeSource{}
+
+	if v, ok := tfMap["component_dependency"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentDependencies = expandComponentDependencyRequirements(v.List())
+	}
+
+	if v, ok := tfMap["component_lambda_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ComponentLambdaParameters = expandLambdaExecutionParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["component_name"].(string); ok && v != "" {
+		apiObject.ComponentName = aws.String(v)
+	}
+
+	if v, ok := tfMap["component_platform"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ComponentPlatforms = expandComponentPlatforms(v)
+	}
+
+	if v, ok := tfMap["component_version"].(string); ok && v != "" {
+		apiObject.ComponentVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["lambda_arn"].(string); ok && v != "" {
+		apiObject.LambdaArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandComponentDependencyRequirements(tfList []interface{}) map[string]*greengrassv2.ComponentDependencyRequirement {
+	apiObjects := make(map[string]*greengrassv2.ComponentDependencyRequirement)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrassv2.ComponentDependencyRequirement{}
+
+		if v, ok := tfMap["dependency_type"].(string); ok && v != "" {
+			apiObject.DependencyType = aws.String(v)
+		}
+
+		if v, ok := tfMap["version_requirement"].(string); ok && v != "" {
+			apiObject.VersionRequirement = aws.String(v)
+		}
+
+		apiObjects[tfMap["component_name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandComponentPlatforms(tfList []interface{}) []*greengrassv2.ComponentPlatform {
+	var apiObjects []*greengrassv2.ComponentPlatform
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrassv2.ComponentPlatform{}
+
+		if v, ok := tfMap["attributes"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Attributes = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLambdaExecutionParameters(tfMap map[string]interface{}) *greengrassv2.LambdaExecutionParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaExecutionParameters{}
+
+	if v, ok := tfMap["environment_variables"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.EnvironmentVariables = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["event_source"].([]interface{}); ok && len(v) > 0 {
+		apiObject.EventSources = expandLambdaEventSources(v)
+	}
+
+	if v, ok := tfMap["exec_args"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ExecArgs = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["input_payload_encoding_type"].(string); ok && v != "" {
+		apiObject.InputPayloadEncodingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["linux_process_params"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LinuxProcessParams = expandLambdaLinuxProcessParams(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["max_idle_time_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaxIdleTimeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_instances_count"].(int); ok && v != 0 {
+		apiObject.MaxInstancesCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_queue_size"].(int); ok && v != 0 {
+		apiObject.MaxQueueSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pinned"].(bool); ok {
+		apiObject.Pinned = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["status_timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.StatusTimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.TimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLambdaEventSources(tfList []interface{}) []*greengrassv2.LambdaEventSource {
+	var apiObjects []*greengrassv2.LambdaEventSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &greengrassv2.LambdaEventSource{
+			Topic: aws.String(tfMap["topic"].(string)),
+			Type:  aws.String(tfMap[names.AttrType].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandLambdaLinuxProcessParams(tfMap map[string]interface{}) *greengrassv2.LambdaLinuxProcessParams {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaLinuxProcessParams{}
+
+	if v, ok := tfMap["container_params"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ContainerParams = expandLambdaContainerParams(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["isolation_mode"].(string); ok && v != "" {
+		apiObject.IsolationMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLambdaContainerParams(tfMap map[string]interface{}) *greengrassv2.LambdaContainerParams {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaContainerParams{}
+
+	if v, ok := tfMap["device"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			device := &greengrassv2.LambdaDeviceMount{
+				AddGroupOwner: aws.Bool(tfMap["add_group_owner"].(bool)),
+				Path:          aws.String(tfMap[names.AttrPath].(string)),
+			}
+
+			if v, ok := tfMap["permission"].(string); ok && v != "" {
+				device.Permission = aws.String(v)
+			}
+
+			apiObject.Devices = append(apiObject.Devices, device)
+		}
+	}
+
+	if v, ok := tfMap["memory_size_in_kb"].(int); ok && v != 0 {
+		apiObject.MemorySizeInKB = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["mount_ro_sysfs"].(bool); ok {
+		apiObject.MountROSysfs = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["volume"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			volume := &greengrassv2.LambdaVolumeMount{
+				AddGroupOwner:   aws.Bool(tfMap["add_group_owner"].(bool)),
+				DestinationPath: aws.String(tfMap["destination_path"].(string)),
+				SourcePath:      aws.String(tfMap["source_path"].(string)),
+			}
+
+			if v, ok := tfMap["permission"].(string); ok && v != "" {
+				volume.Permission = aws.String(v)
+			}
+
+			apiObject.Volumes = append(apiObject.Volumes, volume)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenComponentPlatforms(apiObjects []*greengrassv2.ComponentPlatform) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attributes":   aws.StringValueMap(apiObject.Attributes),
+			names.AttrName: aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
@@ -87,7 +87,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(aws.ToString(output.Group.GroupName))
 
-	_, err = tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	_, err = tfresource.RetryWhenNotFound(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout), func() (interface{}, error) {
 		return findGroupByName(ctx, conn, d.Id())
 	})
 
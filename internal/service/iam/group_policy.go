@@ -98,7 +98,7 @@ func resourceGroupPolicyPut(ctx context.Context, d *schema.ResourceData, meta in
 	if d.IsNewResource() {
 		d.SetId(fmt.Sprintf("%s:%s", groupName, policyName))
 
-		_, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		_, err := tfresource.RetryWhenNotFound(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout), func() (interface{}, error) {
 			return FindGroupPolicyByTwoPartKey(ctx, conn, groupName, policyName)
 		})
 
@@ -119,7 +119,7 @@ func resourceInstanceProfileCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(aws.ToString(output.InstanceProfile.InstanceProfileName))
 
-	_, err = tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	_, err = tfresource.RetryWhenNotFound(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout), func() (interface{}, error) {
 		return findInstanceProfileByName(ctx, conn, d.Id())
 	})
 
@@ -266,7 +266,7 @@ func instanceProfileAddRole(ctx context.Context, conn *iam.Client, profileName,
 		RoleName:            aws.String(roleName),
 	}
 
-	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
+	_, err := tfresource.RetryWhen(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout),
 		func() (interface{}, error) {
 			return conn.AddRoleToInstanceProfile(ctx, input)
 		},
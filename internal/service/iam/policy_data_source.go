@@ -78,7 +78,7 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 	pathPrefix := d.Get("path_prefix").(string)
 
 	if arn == "" {
-		outputRaw, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout,
+		outputRaw, err := tfresource.RetryWhenNotFound(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout),
 			func() (interface{}, error) {
 				return findPolicyByTwoPartKey(ctx, conn, name, pathPrefix)
 			},
@@ -112,7 +112,7 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
-	outputRaw, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout,
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout),
 		func() (interface{}, error) {
 			return findPolicyVersion(ctx, conn, arn, aws.ToString(policy.DefaultVersionId))
 		},
@@ -259,7 +259,7 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout), func() (interface{}, error) {
 		return findRoleByName(ctx, conn, d.Id())
 	}, d.IsNewResource())
 
@@ -348,7 +348,7 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			PolicyDocument: aws.String(assumeRolePolicy),
 		}
 
-		_, err = tfresource.RetryWhen(ctx, propagationTimeout,
+		_, err = tfresource.RetryWhen(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout),
 			func() (interface{}, error) {
 				return conn.UpdateAssumeRolePolicy(ctx, input)
 			},
@@ -537,7 +537,7 @@ func deleteRole(ctx context.Context, conn *iam.Client, roleName string, forceDet
 		RoleName: aws.String(roleName),
 	}
 
-	_, err := tfresource.RetryWhenIsA[*awstypes.DeleteConflictException](ctx, propagationTimeout, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenIsA[*awstypes.DeleteConflictException](ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout), func() (interface{}, error) {
 		return conn.DeleteRole(ctx, input)
 	})
 
@@ -583,7 +583,7 @@ func deleteRoleInstanceProfiles(ctx context.Context, conn *iam.Client, roleName
 }
 
 func retryCreateRole(ctx context.Context, conn *iam.Client, input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
-	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
+	outputRaw, err := tfresource.RetryWhen(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout),
 		func() (interface{}, error) {
 			return conn.CreateRole(ctx, input)
 		},
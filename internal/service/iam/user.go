@@ -143,7 +143,7 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout), func() (interface{}, error) {
 		return findUserByName(ctx, conn, d.Id())
 	}, d.IsNewResource())
 
@@ -436,7 +436,7 @@ func deleteUserLoginProfile(ctx context.Context, conn *iam.Client, username stri
 	input := &iam.DeleteLoginProfileInput{
 		UserName: aws.String(username),
 	}
-	err = retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
+	err = retry.RetryContext(ctx, conns.PropagationTimeout(ctx, names.IAM, propagationTimeout), func() *retry.RetryError {
 		_, err = conn.DeleteLoginProfile(ctx, input)
 		if err != nil {
 			var errNoSuchEntityException *awstypes.NoSuchEntityException
@@ -54,6 +54,8 @@ var validOpenIDURL = validation.All(
 	},
 )
 
+var validPolicyARN = verify.ValidARNCheck(verify.ARNServiceCheck("iam"))
+
 var validRolePolicyRole = validation.All(
 	validation.StringLenBetween(1, 128),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@-]+`), ""),
@@ -131,3 +131,35 @@ func TestValidRolePolicyRoleName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidPolicyARN(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value: "arn:aws:iam::aws:policy/ReadOnlyAccess", // lintignore:AWSAT005
+		},
+		{
+			Value: "arn:aws:iam::123456789012:policy/example", // lintignore:AWSAT005
+		},
+		{
+			Value:    "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy", // lintignore:AWSAT005
+			ErrCount: 1,
+		},
+		{
+			Value:    "ReadOnlyAccess",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validPolicyARN(tc.Value, "policy_arn")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d policy ARN validation errors, got %d", tc.ErrCount, len(errors))
+		}
+	}
+}
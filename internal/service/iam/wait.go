@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -38,7 +39,7 @@ func waitRoleARNIsNotUniqueID(ctx context.Context, conn *iam.Client, id string,
 		Pending:                   []string{RoleStatusARNIsUniqueID, RoleStatusNotFound},
 		Target:                    []string{names.AttrARN},
 		Refresh:                   statusRoleCreate(ctx, conn, id),
-		Timeout:                   propagationTimeout,
+		Timeout:                   conns.PropagationTimeout(ctx, names.IAM, propagationTimeout),
 		NotFoundChecks:            10,
 		ContinuousTargetOccurence: 5,
 	}
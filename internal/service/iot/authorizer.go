@@ -6,6 +6,7 @@ package iot
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -221,7 +222,17 @@ func resourceAuthorizerDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+const (
+	// A custom authorizer can have at most two token signing public keys, which allows
+	// a new key to be added before the old key is removed.
+	authorizerTokenSigningPublicKeysMax = 2
+)
+
 func resourceAuthorizerCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if v := diff.Get("token_signing_public_keys").(map[string]interface{}); len(v) > authorizerTokenSigningPublicKeysMax {
+		return fmt.Errorf(`at most %d "token_signing_public_keys" can be specified, got %d`, authorizerTokenSigningPublicKeysMax, len(v))
+	}
+
 	if !diff.Get("signing_disabled").(bool) {
 		if _, ok := diff.GetOk("token_key_name"); !ok {
 			return errors.New(`"token_key_name" is required when signing is enabled`)
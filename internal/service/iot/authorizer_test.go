@@ -6,8 +6,10 @@ package iot_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/iot"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -156,6 +158,51 @@ func TestAccIoTAuthorizer_update(t *testing.T) {
 	})
 }
 
+func TestAccIoTAuthorizer_tokenSigningPublicKeysRotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf iot.AuthorizerDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_authorizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAuthorizerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizerConfig_tokenSigningPublicKeys(rName, "Key1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthorizerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "token_signing_public_keys.%", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "token_signing_public_keys.Key1"),
+				),
+			},
+			{
+				Config: testAccAuthorizerConfig_tokenSigningPublicKeys(rName, "Key1", "Key2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthorizerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "token_signing_public_keys.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "token_signing_public_keys.Key1"),
+					resource.TestCheckResourceAttrSet(resourceName, "token_signing_public_keys.Key2"),
+				),
+			},
+			{
+				Config:      testAccAuthorizerConfig_tokenSigningPublicKeys(rName, "Key1", "Key2", "Key3"),
+				ExpectError: regexache.MustCompile(`at most 2 "token_signing_public_keys" can be specified, got 3`),
+			},
+			{
+				Config: testAccAuthorizerConfig_tokenSigningPublicKeys(rName, "Key2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthorizerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "token_signing_public_keys.%", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "token_signing_public_keys.Key2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAuthorizerExists(ctx context.Context, n string, v *iot.AuthorizerDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -282,3 +329,21 @@ resource "aws_iot_authorizer" "test" {
 }
 `, rName))
 }
+
+func testAccAuthorizerConfig_tokenSigningPublicKeys(rName string, keyNames ...string) string {
+	var keys strings.Builder
+	for _, keyName := range keyNames {
+		fmt.Fprintf(&keys, "    %s = file(\"test-fixtures/iot-authorizer-signing-key.pem\")\n", keyName)
+	}
+
+	return acctest.ConfigCompose(testAccAuthorizerConfig_base(rName), fmt.Sprintf(`
+resource "aws_iot_authorizer" "test" {
+  name                    = %[1]q
+  authorizer_function_arn = aws_lambda_function.test.arn
+  token_key_name          = "Token-Header-1"
+
+  token_signing_public_keys = {
+%[2]s  }
+}
+`, rName, keys.String()))
+}
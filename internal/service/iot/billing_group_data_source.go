@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iot_billing_group", name="Billing Group")
+// @Tags(identifierAttribute="arn")
+func DataSourceBillingGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBillingGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCreationDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrProperties: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBillingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	output, err := FindBillingGroupByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Billing Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.BillingGroupName))
+	d.Set(names.AttrARN, output.BillingGroupArn)
+	if output.BillingGroupMetadata != nil {
+		if err := d.Set("metadata", []interface{}{flattenBillingGroupMetadata(output.BillingGroupMetadata)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting metadata: %s", err)
+		}
+	} else {
+		d.Set("metadata", nil)
+	}
+	d.Set(names.AttrName, output.BillingGroupName)
+	if v := flattenBillingGroupProperties(output.BillingGroupProperties); len(v) > 0 {
+		if err := d.Set(names.AttrProperties, []interface{}{v}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting properties: %s", err)
+		}
+	} else {
+		d.Set(names.AttrProperties, nil)
+	}
+	d.Set(names.AttrVersion, output.Version)
+
+	return diags
+}
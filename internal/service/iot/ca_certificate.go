@@ -155,7 +155,8 @@ func resourceCACertificateCreate(ctx context.Context, d *schema.ResourceData, me
 		input.RegistrationConfig = expandRegistrationConfig(v.([]interface{})[0].(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("verification_certificate_pem"); ok {
+	// A verification certificate is only used to prove possession of the CA's private key in DEFAULT mode.
+	if v, ok := d.GetOk("verification_certificate_pem"); ok && d.Get("certificate_mode").(string) == iot.CertificateModeDefault {
 		input.VerificationCertificate = aws.String(v.(string))
 	}
 
@@ -163,6 +163,31 @@ func TestAccIoTCACertificate_defaultMode(t *testing.T) {
 	})
 }
 
+func TestAccIoTCACertificate_sniOnlyWithVerificationCertificate(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iot_ca_certificate.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, caKey)
+	verificationKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	verificationCertificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, verificationKey, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCACertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCACertificateConfig_sniOnlyWithVerificationCertificate(caCertificate, verificationCertificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCACertificateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_mode", "SNI_ONLY"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIoTCACertificate_registrationConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_iot_ca_certificate.test"
@@ -245,6 +270,18 @@ resource "aws_iot_ca_certificate" "test" {
 `, acctest.TLSPEMEscapeNewlines(caCertificate))
 }
 
+func testAccCACertificateConfig_sniOnlyWithVerificationCertificate(caCertificate, verificationCertificate string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_ca_certificate" "test" {
+  active                       = true
+  allow_auto_registration      = true
+  ca_certificate_pem           = "%[1]s"
+  certificate_mode             = "SNI_ONLY"
+  verification_certificate_pem = "%[2]s"
+}
+`, acctest.TLSPEMEscapeNewlines(caCertificate), acctest.TLSPEMEscapeNewlines(verificationCertificate))
+}
+
 func testAccCACertificateConfig_tags1(caCertificate, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_iot_ca_certificate" "test" {
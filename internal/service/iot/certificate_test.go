@@ -93,6 +93,11 @@ func TestAccIoTCertificate_Keys_existingCertificate(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, names.AttrPublicKey),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccCertificateConfig_existingCertificate(certificate, true),
 				Check: resource.ComposeTestCheckFunc(
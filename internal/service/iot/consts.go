@@ -10,3 +10,9 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	// IoT policies can have at most 5 versions.
+	maxPolicyVersions                 = 5
+	defaultRetainedPolicyVersionCount = 1
+)
@@ -84,6 +84,19 @@ func ResourceDomainConfiguration() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"server_certificate_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_ocsp_check": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"service_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -146,6 +159,10 @@ func resourceDomainConfigurationCreate(ctx context.Context, d *schema.ResourceDa
 		input.ServerCertificateArns = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("server_certificate_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ServerCertificateConfig = expandServerCertificateConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("service_type"); ok {
 		input.ServiceType = aws.String(v.(string))
 	}
@@ -199,6 +216,14 @@ func resourceDomainConfigurationRead(ctx context.Context, d *schema.ResourceData
 	d.Set("server_certificate_arns", tfslices.ApplyToAll(output.ServerCertificates, func(v *iot.ServerCertificateSummary) string {
 		return aws.StringValue(v.ServerCertificateArn)
 	}))
+	// The API returns a disabled configuration for domain configurations that never set one.
+	if v := output.ServerCertificateConfig; v != nil && (aws.BoolValue(v.EnableOCSPCheck) || len(d.Get("server_certificate_config").([]interface{})) > 0) {
+		if err := d.Set("server_certificate_config", []interface{}{flattenServerCertificateConfig(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting server_certificate_config: %s", err)
+		}
+	} else {
+		d.Set("server_certificate_config", nil)
+	}
 	d.Set("service_type", output.ServiceType)
 	d.Set(names.AttrStatus, output.DomainConfigurationStatus)
 	if output.TlsConfig != nil {
@@ -234,6 +259,16 @@ func resourceDomainConfigurationUpdate(ctx context.Context, d *schema.ResourceDa
 			input.DomainConfigurationStatus = aws.String(d.Get(names.AttrStatus).(string))
 		}
 
+		if d.HasChange("server_certificate_config") {
+			if v, ok := d.GetOk("server_certificate_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ServerCertificateConfig = expandServerCertificateConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.ServerCertificateConfig = &iot.ServerCertificateConfig{
+					EnableOCSPCheck: aws.Bool(false),
+				}
+			}
+		}
+
 		if d.HasChange("tls_config") {
 			if v, ok := d.GetOk("tls_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.TlsConfig = expandTlsConfig(v.([]interface{})[0].(map[string]interface{}))
@@ -343,6 +378,20 @@ func expandTlsConfig(tfMap map[string]interface{}) *iot.TlsConfig { // nosemgrep
 	return apiObject
 }
 
+func expandServerCertificateConfig(tfMap map[string]interface{}) *iot.ServerCertificateConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.ServerCertificateConfig{}
+
+	if v, ok := tfMap["enable_ocsp_check"].(bool); ok {
+		apiObject.EnableOCSPCheck = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
 func flattenAuthorizerConfig(apiObject *iot.AuthorizerConfig) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -374,3 +423,17 @@ func flattenTlsConfig(apiObject *iot.TlsConfig) map[string]interface{} { // nose
 
 	return tfMap
 }
+
+func flattenServerCertificateConfig(apiObject *iot.ServerCertificateConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EnableOCSPCheck; v != nil {
+		tfMap["enable_ocsp_check"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
@@ -173,6 +173,58 @@ func TestAccIoTDomainConfiguration_update(t *testing.T) {
 	})
 }
 
+func TestAccIoTDomainConfiguration_serverCertificateConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	resourceName := "aws_iot_domain_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfigurationConfig_serverCertificateConfig(rName, rootDomain, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.0.enable_ocsp_check", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfigurationConfig_serverCertificateConfig(rName, rootDomain, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.0.enable_ocsp_check", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccDomainConfigurationConfig_serverCertificateConfig(rName, rootDomain, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.0.enable_ocsp_check", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccDomainConfigurationConfig_basic(rName, rootDomain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIoTDomainConfiguration_awsManaged(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -344,6 +396,22 @@ resource "aws_iot_domain_configuration" "test" {
 `, rName, domain, securityPolicy, allowAuthorizerOverride))
 }
 
+func testAccDomainConfigurationConfig_serverCertificateConfig(rName, rootDomain, domain string, enableOCSPCheck bool) string {
+	return acctest.ConfigCompose(testAccDomainConfigurationConfig_base(rootDomain, domain), fmt.Sprintf(`
+resource "aws_iot_domain_configuration" "test" {
+  depends_on = [aws_acm_certificate_validation.test]
+
+  name                    = %[1]q
+  domain_name             = %[2]q
+  server_certificate_arns = [aws_acm_certificate.test.arn]
+
+  server_certificate_config {
+    enable_ocsp_check = %[3]t
+  }
+}
+`, rName, domain, enableOCSPCheck))
+}
+
 func testAccDomainConfigurationConfig_awsManaged(rName string) string { // nosemgrep:ci.aws-in-func-name
 	return fmt.Sprintf(`
 resource "aws_iot_domain_configuration" "test" {
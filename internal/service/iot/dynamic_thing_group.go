@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iot_dynamic_thing_group", name="Dynamic Thing Group")
+// @Tags(identifierAttribute="arn")
+func ResourceDynamicThingGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDynamicThingGroupCreate,
+		ReadWithoutTimeout:   resourceDynamicThingGroupRead,
+		UpdateWithoutTimeout: resourceDynamicThingGroupUpdate,
+		DeleteWithoutTimeout: resourceDynamicThingGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCreationDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrProperties: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_payload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAttributes: {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"query_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDynamicThingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iot.CreateDynamicThingGroupInput{
+		QueryString:    aws.String(d.Get("query_string").(string)),
+		Tags:           getTagsIn(ctx),
+		ThingGroupName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("index_name"); ok {
+		input.IndexName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrProperties); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ThingGroupProperties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("query_version"); ok {
+		input.QueryVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDynamicThingGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Dynamic Thing Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ThingGroupName))
+
+	if _, err := waitDynamicThingGroupActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Dynamic Thing Group (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDynamicThingGroupRead(ctx, d, meta)...)
+}
+
+func resourceDynamicThingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	output, err := FindDynamicThingGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Dynamic Thing Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Dynamic Thing Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ThingGroupArn)
+	d.Set("index_name", output.IndexName)
+	if output.ThingGroupMetadata != nil {
+		if err := d.Set("metadata", []interface{}{flattenDynamicThingGroupMetadata(output.ThingGroupMetadata)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting metadata: %s", err)
+		}
+	} else {
+		d.Set("metadata", nil)
+	}
+	d.Set(names.AttrName, output.ThingGroupName)
+	if v := flattenThingGroupProperties(output.ThingGroupProperties); len(v) > 0 {
+		if err := d.Set(names.AttrProperties, []interface{}{v}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting properties: %s", err)
+		}
+	} else {
+		d.Set(names.AttrProperties, nil)
+	}
+	d.Set("query_string", output.QueryString)
+	d.Set("query_version", output.QueryVersion)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrVersion, output.Version)
+
+	return diags
+}
+
+func resourceDynamicThingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iot.UpdateDynamicThingGroupInput{
+			ExpectedVersion: aws.Int64(int64(d.Get(names.AttrVersion).(int))),
+			QueryString:     aws.String(d.Get("query_string").(string)),
+			ThingGroupName:  aws.String(d.Id()),
+		}
+
+		if d.HasChange("index_name") {
+			input.IndexName = aws.String(d.Get("index_name").(string))
+		}
+
+		if v, ok := d.GetOk(names.AttrProperties); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ThingGroupProperties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.ThingGroupProperties = &iot.ThingGroupProperties{}
+		}
+
+		// https://docs.aws.amazon.com/iot/latest/apireference/API_AttributePayload.html#API_AttributePayload_Contents:
+		// "To remove an attribute, call UpdateThing with an empty attribute value."
+		if input.ThingGroupProperties.AttributePayload == nil {
+			input.ThingGroupProperties.AttributePayload = &iot.AttributePayload{
+				Attributes: map[string]*string{},
+			}
+		}
+
+		if d.HasChange("query_version") {
+			input.QueryVersion = aws.String(d.Get("query_version").(string))
+		}
+
+		_, err := conn.UpdateDynamicThingGroupWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Dynamic Thing Group (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDynamicThingGroupActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Dynamic Thing Group (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDynamicThingGroupRead(ctx, d, meta)...)
+}
+
+func resourceDynamicThingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Dynamic Thing Group: %s", d.Id())
+	_, err := conn.DeleteDynamicThingGroupWithContext(ctx, &iot.DeleteDynamicThingGroupInput{
+		ThingGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Dynamic Thing Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDynamicThingGroupByName(ctx context.Context, conn *iot.IoT, name string) (*iot.DescribeThingGroupOutput, error) {
+	output, err := FindThingGroupByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A static thing group with the same name is not a dynamic thing group.
+	if output.QueryString == nil {
+		return nil, &retry.NotFoundError{
+			Message: "not a dynamic thing group",
+		}
+	}
+
+	return output, nil
+}
+
+// Dynamic thing groups cannot be nested, so only the creation date is of interest.
+func flattenDynamicThingGroupMetadata(apiObject *iot.ThingGroupMetadata) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CreationDate; v != nil {
+		tfMap[names.AttrCreationDate] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func statusDynamicThingGroup(ctx context.Context, conn *iot.IoT, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDynamicThingGroupByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitDynamicThingGroupActive(ctx context.Context, conn *iot.IoT, name string, timeout time.Duration) (*iot.DescribeThingGroupOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iot.DynamicGroupStatusBuilding, iot.DynamicGroupStatusRebuilding},
+		Target:  []string{iot.DynamicGroupStatusActive},
+		Refresh: statusDynamicThingGroup(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iot.DescribeThingGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
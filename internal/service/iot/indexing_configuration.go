@@ -5,6 +5,7 @@ package iot
 
 import (
 	"context"
+	"log"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,13 +25,18 @@ func ResourceIndexingConfiguration() *schema.Resource {
 		CreateWithoutTimeout: resourceIndexingConfigurationPut,
 		ReadWithoutTimeout:   resourceIndexingConfigurationRead,
 		UpdateWithoutTimeout: resourceIndexingConfigurationPut,
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourceIndexingConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"disable_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"thing_group_indexing_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -119,6 +125,24 @@ func ResourceIndexingConfiguration() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"geo_location": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrName: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"order": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Default:      iot.TargetFieldOrderLatLon,
+													ValidateFunc: validation.StringInSlice(iot.TargetFieldOrder_Values(), false),
+												},
+											},
+										},
+									},
 									"named_shadow_names": {
 										Type:     schema.TypeSet,
 										Optional: true,
@@ -232,6 +256,38 @@ func resourceIndexingConfigurationRead(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+func resourceIndexingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !d.Get("disable_on_destroy").(bool) {
+		log.Printf("[DEBUG] Retaining IoT Indexing Configuration: %s", d.Id())
+		return diags
+	}
+
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	input := &iot.UpdateIndexingConfigurationInput{
+		ThingGroupIndexingConfiguration: &iot.ThingGroupIndexingConfiguration{
+			ThingGroupIndexingMode: aws.String(iot.ThingGroupIndexingModeOff),
+		},
+		ThingIndexingConfiguration: &iot.ThingIndexingConfiguration{
+			DeviceDefenderIndexingMode:    aws.String(iot.DeviceDefenderIndexingModeOff),
+			NamedShadowIndexingMode:       aws.String(iot.NamedShadowIndexingModeOff),
+			ThingConnectivityIndexingMode: aws.String(iot.ThingConnectivityIndexingModeOff),
+			ThingIndexingMode:             aws.String(iot.ThingIndexingModeOff),
+		},
+	}
+
+	log.Printf("[DEBUG] Disabling IoT Indexing Configuration: %s", d.Id())
+	_, err := conn.UpdateIndexingConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling IoT Indexing Configuration: %s", err)
+	}
+
+	return diags
+}
+
 func flattenThingGroupIndexingConfiguration(apiObject *iot.ThingGroupIndexingConfiguration) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -299,6 +355,10 @@ func flattenIndexingFilter(apiObject *iot.IndexingFilter) map[string]interface{}
 
 	tfMap := map[string]interface{}{}
 
+	if v := apiObject.GeoLocations; v != nil {
+		tfMap["geo_location"] = flattenGeoLocationTargets(v)
+	}
+
 	if v := apiObject.NamedShadowNames; v != nil {
 		tfMap["named_shadow_names"] = aws.StringValueSlice(v)
 	}
@@ -306,6 +366,42 @@ func flattenIndexingFilter(apiObject *iot.IndexingFilter) map[string]interface{}
 	return tfMap
 }
 
+func flattenGeoLocationTarget(apiObject *iot.GeoLocationTarget) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap[names.AttrName] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Order; v != nil {
+		tfMap["order"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenGeoLocationTargets(apiObjects []*iot.GeoLocationTarget) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenGeoLocationTarget(apiObject))
+	}
+
+	return tfList
+}
+
 func flattenField(apiObject *iot.Field) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -409,6 +505,10 @@ func expandIndexingFilter(tfMap map[string]interface{}) *iot.IndexingFilter {
 
 	apiObject := &iot.IndexingFilter{}
 
+	if v, ok := tfMap["geo_location"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.GeoLocations = expandGeoLocationTargets(v.List())
+	}
+
 	if v, ok := tfMap["named_shadow_names"].(*schema.Set); ok && v.Len() > 0 {
 		apiObject.NamedShadowNames = flex.ExpandStringSet(v)
 	}
@@ -416,6 +516,50 @@ func expandIndexingFilter(tfMap map[string]interface{}) *iot.IndexingFilter {
 	return apiObject
 }
 
+func expandGeoLocationTarget(tfMap map[string]interface{}) *iot.GeoLocationTarget {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.GeoLocationTarget{}
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["order"].(string); ok && v != "" {
+		apiObject.Order = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGeoLocationTargets(tfList []interface{}) []*iot.GeoLocationTarget {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iot.GeoLocationTarget
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandGeoLocationTarget(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandField(tfMap map[string]interface{}) *iot.Field {
 	if tfMap == nil {
 		return nil
@@ -4,10 +4,16 @@
 package iot_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -15,8 +21,13 @@ func TestAccIoTIndexingConfiguration_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		acctest.CtBasic: testAccIndexingConfiguration_basic,
-		"allAttributes": testAccIndexingConfiguration_allAttributes,
+		acctest.CtBasic:               testAccIndexingConfiguration_basic,
+		"allAttributes":               testAccIndexingConfiguration_allAttributes,
+		"disableOnDestroy":            testAccIndexingConfiguration_disableOnDestroy,
+		"dynamicThingGroupBasic":      testAccDynamicThingGroup_basic,
+		"dynamicThingGroupDisappears": testAccDynamicThingGroup_disappears,
+		"dynamicThingGroupUpdate":     testAccDynamicThingGroup_update,
+		"thingsDataSource":            testAccThingsDataSource_basic,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -49,9 +60,10 @@ func testAccIndexingConfiguration_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_on_destroy"},
 			},
 		},
 	})
@@ -96,17 +108,73 @@ func testAccIndexingConfiguration_allAttributes(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.#", acctest.Ct2),
 					resource.TestCheckTypeSetElemAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.*", "thing1shadow"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.*", "$package"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.0.geo_location.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_indexing_configuration.0.filter.0.geo_location.*", map[string]string{
+						names.AttrName: "shadow.name.thing1shadow.reported.location",
+						"order":        "LonLat",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccIndexingConfiguration_disableOnDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iot_indexing_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexingConfigurationDisabled(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexingConfigurationConfig_disableOnDestroy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disable_on_destroy", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.0.thing_group_indexing_mode", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_indexing_mode", "REGISTRY"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_on_destroy"},
 			},
 		},
 	})
 }
 
+func testAccCheckIndexingConfigurationDisabled(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		output, err := conn.GetIndexingConfigurationWithContext(ctx, &iot.GetIndexingConfigurationInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if v := aws.StringValue(output.ThingGroupIndexingConfiguration.ThingGroupIndexingMode); v != iot.ThingGroupIndexingModeOff {
+			return fmt.Errorf("IoT Indexing Configuration thing group indexing mode is %s", v)
+		}
+
+		if v := aws.StringValue(output.ThingIndexingConfiguration.ThingIndexingMode); v != iot.ThingIndexingModeOff {
+			return fmt.Errorf("IoT Indexing Configuration thing indexing mode is %s", v)
+		}
+
+		return nil
+	}
+}
+
 const testAccIndexingConfigurationConfig_basic = `
 resource "aws_iot_indexing_configuration" "test" {
   thing_group_indexing_configuration {
@@ -133,6 +201,11 @@ resource "aws_iot_indexing_configuration" "test" {
 
     filter {
       named_shadow_names = ["thing1shadow", "$package"]
+
+      geo_location {
+        name  = "shadow.name.thing1shadow.reported.location"
+        order = "LonLat"
+      }
     }
 
     custom_field {
@@ -150,3 +223,17 @@ resource "aws_iot_indexing_configuration" "test" {
   }
 }
 `
+
+const testAccIndexingConfigurationConfig_disableOnDestroy = `
+resource "aws_iot_indexing_configuration" "test" {
+  disable_on_destroy = true
+
+  thing_group_indexing_configuration {
+    thing_group_indexing_mode = "ON"
+  }
+
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+  }
+}
+`
@@ -17,8 +17,10 @@ func TestAccIoTLoggingOptions_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		acctest.CtBasic: testAccLoggingOptions_basic,
-		"update":        testAccLoggingOptions_update,
+		acctest.CtBasic:           testAccLoggingOptions_basic,
+		"update":                  testAccLoggingOptions_update,
+		"loggingTarget":           testAccLoggingTarget_basic,
+		"loggingTargetDisappears": testAccLoggingTarget_disappears,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
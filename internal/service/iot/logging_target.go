@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_iot_logging_target", name="Logging Target")
+func ResourceLoggingTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLoggingTargetPut,
+		ReadWithoutTimeout:   resourceLoggingTargetRead,
+		UpdateWithoutTimeout: resourceLoggingTargetPut,
+		DeleteWithoutTimeout: resourceLoggingTargetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_level": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(iot.LogLevel_Values(), false),
+			},
+			"target_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					iot.LogTargetTypeClientId,
+					iot.LogTargetTypePrincipalId,
+					iot.LogTargetTypeSourceIp,
+					iot.LogTargetTypeThingGroup,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceLoggingTargetPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	targetType := d.Get("target_type").(string)
+	targetName := d.Get("target_name").(string)
+	id := LoggingTargetCreateResourceID(targetType, targetName)
+	input := &iot.SetV2LoggingLevelInput{
+		LogLevel: aws.String(d.Get("log_level").(string)),
+		LogTarget: &iot.LogTarget{
+			TargetName: aws.String(targetName),
+			TargetType: aws.String(targetType),
+		},
+	}
+
+	_, err := conn.SetV2LoggingLevelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting IoT Logging Target (%s) log level: %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceLoggingTargetRead(ctx, d, meta)...)
+}
+
+func resourceLoggingTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	targetType, targetName, err := LoggingTargetParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Logging Target (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindLoggingTargetByTwoPartKey(ctx, conn, targetType, targetName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Logging Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Logging Target (%s): %s", d.Id(), err)
+	}
+
+	d.Set("log_level", output.LogLevel)
+	d.Set("target_name", output.LogTarget.TargetName)
+	d.Set("target_type", output.LogTarget.TargetType)
+
+	return diags
+}
+
+func resourceLoggingTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	targetType, targetName, err := LoggingTargetParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Logging Target (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Logging Target: %s", d.Id())
+	_, err = conn.DeleteV2LoggingLevelWithContext(ctx, &iot.DeleteV2LoggingLevelInput{
+		TargetName: aws.String(targetName),
+		TargetType: aws.String(targetType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Logging Target (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindLoggingTargetByTwoPartKey(ctx context.Context, conn *iot.IoT, targetType, targetName string) (*iot.LogTargetConfiguration, error) {
+	input := &iot.ListV2LoggingLevelsInput{
+		TargetType: aws.String(targetType),
+	}
+
+	var output *iot.LogTargetConfiguration
+
+	err := conn.ListV2LoggingLevelsPagesWithContext(ctx, input, func(page *iot.ListV2LoggingLevelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LogTargetConfigurations {
+			if v == nil || v.LogTarget == nil {
+				continue
+			}
+
+			if aws.StringValue(v.LogTarget.TargetType) == targetType && aws.StringValue(v.LogTarget.TargetName) == targetName {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+const loggingTargetResourceIDSeparator = "/"
+
+func LoggingTargetCreateResourceID(targetType, targetName string) string {
+	parts := []string{targetType, targetName}
+	id := strings.Join(parts, loggingTargetResourceIDSeparator)
+
+	return id
+}
+
+func LoggingTargetParseResourceID(id string) (string, string, error) {
+	// The target name (e.g. an MQTT client ID) may itself contain the separator.
+	parts := strings.SplitN(id, loggingTargetResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected target-type%[2]starget-name", id, loggingTargetResourceIDSeparator)
+}
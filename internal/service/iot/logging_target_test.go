@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccLoggingTarget_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_logging_target.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoggingTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingTargetConfig_basic(rName, "DEBUG"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoggingTargetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_level", "DEBUG"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_name", "aws_iot_thing_group.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "THING_GROUP"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLoggingTargetConfig_basic(rName, "ERROR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoggingTargetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_level", "ERROR"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLoggingTarget_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_logging_target.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoggingTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingTargetConfig_basic(rName, "DEBUG"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingTargetExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiot.ResourceLoggingTarget(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLoggingTargetExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		_, err := tfiot.FindLoggingTargetByTwoPartKey(ctx, conn, rs.Primary.Attributes["target_type"], rs.Primary.Attributes["target_name"])
+
+		return err
+	}
+}
+
+func testAccCheckLoggingTargetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iot_logging_target" {
+				continue
+			}
+
+			_, err := tfiot.FindLoggingTargetByTwoPartKey(ctx, conn, rs.Primary.Attributes["target_type"], rs.Primary.Attributes["target_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Logging Target %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLoggingTargetConfig_basic(rName, logLevel string) string {
+	return acctest.ConfigCompose(testAccLoggingOptionsConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iot_logging_target" "test" {
+  log_level   = %[2]q
+  target_name = aws_iot_thing_group.test.name
+  target_type = "THING_GROUP"
+
+  depends_on = [aws_iot_logging_options.test]
+}
+`, rName, logLevel))
+}
@@ -15,12 +15,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -37,7 +39,13 @@ func ResourcePolicy() *schema.Resource {
 		DeleteWithoutTimeout: resourcePolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("fail_on_version_limit", false)
+				d.Set("prune_versions", false)
+				d.Set("retained_version_count", defaultRetainedPolicyVersionCount)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -54,6 +62,12 @@ func ResourcePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"fail_on_version_limit": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"prune_versions"},
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -62,7 +76,7 @@ func ResourcePolicy() *schema.Resource {
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
 				Required:              true,
-				ValidateFunc:          validation.StringIsJSON,
+				ValidateFunc:          validPolicyDocument,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
@@ -70,11 +84,29 @@ func ResourcePolicy() *schema.Resource {
 					return json
 				},
 			},
+			"prune_versions": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"fail_on_version_limit"},
+			},
+			"retained_version_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultRetainedPolicyVersionCount,
+				ValidateFunc: validation.IntBetween(0, maxPolicyVersions-1),
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// Each policy document change creates a new default policy version.
+			customdiff.ComputedIf("default_version_id", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange(names.AttrPolicy)
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -139,7 +171,7 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IoTConn(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange(names.AttrPolicy) {
 		policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", policy, err)
@@ -154,7 +186,7 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		_, errCreate := conn.CreatePolicyVersionWithContext(ctx, input)
 
 		// "VersionsLimitExceededException: The policy ... already has the maximum number of versions (5)"
-		if tfawserr.ErrCodeEquals(errCreate, iot.ErrCodeVersionsLimitExceededException) {
+		if tfawserr.ErrCodeEquals(errCreate, iot.ErrCodeVersionsLimitExceededException) && !d.Get("fail_on_version_limit").(bool) {
 			// Prune the lowest version and retry.
 			policyVersions, err := FindPolicyVersionsByName(ctx, conn, d.Id())
 
@@ -198,7 +230,14 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		if errCreate != nil {
 			return sdkdiag.AppendErrorf(diags, "updating IoT Policy (%s): %s", d.Id(), errCreate)
 		}
+
+		if d.Get("prune_versions").(bool) {
+			if err := deleteNonDefaultPolicyVersions(ctx, conn, d.Id(), d.Get("retained_version_count").(int), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 	}
+
 	return append(diags, resourcePolicyRead(ctx, d, meta)...)
 }
 
@@ -206,25 +245,15 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IoTConn(ctx)
 
-	policyVersions, err := FindPolicyVersionsByName(ctx, conn, d.Id())
+	// Delete all non-default versions of the policy.
+	err := deleteNonDefaultPolicyVersions(ctx, conn, d.Id(), 0, d.Timeout(schema.TimeoutDelete))
 
 	if tfresource.NotFound(err) {
 		return diags
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading IoT Policy (%s) versions: %s", d.Id(), err)
-	}
-
-	// Delete all non-default versions of the policy.
-	for _, v := range policyVersions {
-		if aws.BoolValue(v.IsDefaultVersion) {
-			continue
-		}
-
-		if err := deletePolicyVersion(ctx, conn, d.Id(), aws.StringValue(v.VersionId), d.Timeout(schema.TimeoutDelete)); err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
-		}
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	// Delete default policy version.
@@ -305,6 +334,55 @@ func deletePolicy(ctx context.Context, conn *iot.IoT, name string, timeout time.
 	return nil
 }
 
+// deleteNonDefaultPolicyVersions deletes the policy's non-default versions, except for the specified number of most recent ones.
+func deleteNonDefaultPolicyVersions(ctx context.Context, conn *iot.IoT, name string, retain int, timeout time.Duration) error {
+	policyVersions, err := FindPolicyVersionsByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IoT Policy (%s) versions: %w", name, err)
+	}
+
+	for _, versionID := range policyVersionsToPrune(policyVersions, retain) {
+		if err := deletePolicyVersion(ctx, conn, name, versionID, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// policyVersionsToPrune returns the IDs of the policy's non-default versions, oldest first, except for the specified number of most recent ones.
+func policyVersionsToPrune(policyVersions []*iot.PolicyVersion, retain int) []string {
+	var versionIDs []int
+
+	for _, v := range policyVersions {
+		if aws.BoolValue(v.IsDefaultVersion) {
+			continue
+		}
+
+		v, err := strconv.Atoi(aws.StringValue(v.VersionId))
+
+		if err != nil {
+			continue
+		}
+
+		versionIDs = append(versionIDs, v)
+	}
+
+	// Sort ascending.
+	slices.Sort(versionIDs)
+
+	if retain >= len(versionIDs) {
+		return nil
+	}
+
+	return tfslices.ApplyToAll(versionIDs[:len(versionIDs)-retain], strconv.Itoa)
+}
+
 func deletePolicyVersion(ctx context.Context, conn *iot.IoT, name, versionID string, timeout time.Duration) error {
 	input := &iot.DeletePolicyVersionInput{
 		PolicyName:      aws.String(name),
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/google/go-cmp/cmp"
@@ -23,6 +24,52 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestPolicyVersionsToPrune(t *testing.T) {
+	t.Parallel()
+
+	policyVersions := []*iot.PolicyVersion{
+		{VersionId: aws.String("3"), IsDefaultVersion: aws.Bool(false)},
+		{VersionId: aws.String("5"), IsDefaultVersion: aws.Bool(true)},
+		{VersionId: aws.String("1"), IsDefaultVersion: aws.Bool(false)},
+		{VersionId: aws.String("4"), IsDefaultVersion: aws.Bool(false)},
+		{VersionId: aws.String("2"), IsDefaultVersion: aws.Bool(false)},
+	}
+
+	testCases := []struct {
+		retain   int
+		expected []string
+	}{
+		{
+			retain:   0,
+			expected: []string{"1", "2", "3", "4"},
+		},
+		{
+			retain:   1,
+			expected: []string{"1", "2", "3"},
+		},
+		{
+			retain:   3,
+			expected: []string{"1"},
+		},
+		{
+			retain: 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("retain %d", testCase.retain), func(t *testing.T) {
+			t.Parallel()
+
+			got := tfiot.PolicyVersionsToPrune(policyVersions, testCase.retain)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestAccIoTPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v iot.GetPolicyOutput
@@ -242,6 +289,131 @@ func TestAccIoTPolicy_prune(t *testing.T) {
 	})
 }
 
+func TestAccIoTPolicy_pruneVersions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.GetPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// lintignore:AWSAT005
+				Config: testAccPolicyConfig_versionManagement(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), "prune_versions"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct1),
+					testAccCheckPolicyVersionIDs(ctx, resourceName, []string{acctest.Ct1}),
+				),
+			},
+			{
+				// lintignore:AWSAT005
+				Config: testAccPolicyConfig_versionManagement(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), "prune_versions"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct2),
+					testAccCheckPolicyVersionIDs(ctx, resourceName, []string{acctest.Ct1, acctest.Ct2}),
+				),
+			},
+			{
+				// lintignore:AWSAT005
+				Config: testAccPolicyConfig_versionManagement(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), "prune_versions"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct3),
+					testAccCheckPolicyVersionIDs(ctx, resourceName, []string{acctest.Ct2, acctest.Ct3}),
+				),
+			},
+			{
+				// lintignore:AWSAT005
+				Config: testAccPolicyConfig_pruneVersionsRetained(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct4),
+					resource.TestCheckResourceAttr(resourceName, "retained_version_count", acctest.Ct2),
+					testAccCheckPolicyVersionIDs(ctx, resourceName, []string{acctest.Ct2, acctest.Ct3, acctest.Ct4}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prune_versions", "retained_version_count"},
+			},
+		},
+	})
+}
+
+func TestAccIoTPolicy_failOnVersionLimit(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.GetPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// lintignore:AWSAT005
+				Config: testAccPolicyConfig_versionManagement(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), "fail_on_version_limit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct1),
+					testAccCheckPolicyVersionIDs(ctx, resourceName, []string{acctest.Ct1}),
+				),
+			},
+			{
+				// lintignore:AWSAT005
+				Config: testAccPolicyConfig_versionManagement(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), "fail_on_version_limit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct2),
+					testAccCheckPolicyVersionIDs(ctx, resourceName, []string{acctest.Ct1, acctest.Ct2}),
+				),
+			},
+			{
+				// lintignore:AWSAT005
+				Config: testAccPolicyConfig_versionManagement(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), "fail_on_version_limit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct3),
+					testAccCheckPolicyVersionIDs(ctx, resourceName, []string{acctest.Ct1, acctest.Ct2, acctest.Ct3}),
+				),
+			},
+			{
+				// lintignore:AWSAT005
+				Config: testAccPolicyConfig_versionManagement(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), "fail_on_version_limit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct4),
+					testAccCheckPolicyVersionIDs(ctx, resourceName, []string{acctest.Ct1, acctest.Ct2, acctest.Ct3, acctest.Ct4}),
+				),
+			},
+			{
+				// lintignore:AWSAT005
+				Config: testAccPolicyConfig_versionManagement(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), "fail_on_version_limit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", "5"),
+					testAccCheckPolicyVersionIDs(ctx, resourceName, []string{acctest.Ct1, acctest.Ct2, acctest.Ct3, acctest.Ct4, "5"}),
+				),
+			},
+			{
+				// lintignore:AWSAT005
+				Config:      testAccPolicyConfig_versionManagement(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), "fail_on_version_limit"),
+				ExpectError: regexache.MustCompile(`VersionsLimitExceededException`),
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
@@ -426,3 +598,58 @@ EOF
 }
 `, rName, resourceName)
 }
+
+func testAccPolicyConfig_versionManagement(rName, resourceName, attribute string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_policy" "test" {
+  name = %[1]q
+
+  %[3]s = true
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "iot:*"
+      ],
+      "Resource": [
+        %[2]q
+      ]
+    }
+  ]
+}
+EOF
+}
+`, rName, resourceName, attribute)
+}
+
+func testAccPolicyConfig_pruneVersionsRetained(rName, resourceName string, retainedVersionCount int) string {
+	return fmt.Sprintf(`
+resource "aws_iot_policy" "test" {
+  name = %[1]q
+
+  prune_versions         = true
+  retained_version_count = %[3]d
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "iot:*"
+      ],
+      "Resource": [
+        %[2]q
+      ]
+    }
+  ]
+}
+EOF
+}
+`, rName, resourceName, retainedVersionCount)
+}
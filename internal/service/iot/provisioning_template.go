@@ -5,13 +5,16 @@ package iot
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,6 +30,11 @@ const (
 	provisioningHookPayloadVersion2020_04_01 = "2020-04-01"
 )
 
+const (
+	// https://docs.aws.amazon.com/general/latest/gr/iot-core.html#fleet-provisioning-limits.
+	provisioningTemplateVersionsMax = 5
+)
+
 func provisioningHookPayloadVersion_Values() []string {
 	return []string{
 		provisioningHookPayloadVersion2020_04_01,
@@ -94,6 +102,11 @@ func ResourceProvisioningTemplate() *schema.Resource {
 					},
 				},
 			},
+			"previous_versions_to_keep": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, provisioningTemplateVersionsMax-1),
+			},
 			"provisioning_role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -118,10 +131,24 @@ func ResourceProvisioningTemplate() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceProvisioningTemplateCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceProvisioningTemplateCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Pre-provisioning hooks are only invoked during fleet provisioning.
+	if diff.Get(names.AttrType).(string) == iot.TemplateTypeJitp {
+		if v, ok := diff.GetOk("pre_provisioning_hook"); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf(`"pre_provisioning_hook" cannot be specified when type is %q`, iot.TemplateTypeJitp)
+		}
+	}
+
+	return nil
+}
+
 func resourceProvisioningTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -211,6 +238,12 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 	conn := meta.(*conns.AWSClient).IoTConn(ctx)
 
 	if d.HasChange("template_body") {
+		if v, ok := d.GetOk("previous_versions_to_keep"); ok {
+			if err := pruneProvisioningTemplateVersions(ctx, conn, d.Id(), v.(int)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
 		input := &iot.CreateProvisioningTemplateVersionInput{
 			SetAsDefault: aws.Bool(true),
 			TemplateBody: aws.String(d.Get("template_body").(string)),
@@ -305,6 +338,91 @@ func expandProvisioningHook(tfMap map[string]interface{}) *iot.ProvisioningHook
 	return apiObject
 }
 
+// pruneProvisioningTemplateVersions deletes the oldest non-default versions of the
+// specified provisioning template so that at most keep versions remain, leaving room
+// for a new version to be created.
+func pruneProvisioningTemplateVersions(ctx context.Context, conn *iot.IoT, name string, keep int) error {
+	versions, err := FindProvisioningTemplateVersionsByName(ctx, conn, name)
+
+	if err != nil {
+		return fmt.Errorf("reading IoT Provisioning Template (%s) versions: %w", name, err)
+	}
+
+	if len(versions) <= keep {
+		return nil
+	}
+
+	// Newest first.
+	sort.Slice(versions, func(i, j int) bool {
+		return aws.Int64Value(versions[i].VersionId) > aws.Int64Value(versions[j].VersionId)
+	})
+
+	// The default version always counts towards the versions that are kept.
+	kept := 1
+	for _, v := range versions {
+		if aws.BoolValue(v.IsDefaultVersion) {
+			continue
+		}
+
+		if kept < keep {
+			kept++
+			continue
+		}
+
+		versionID := aws.Int64Value(v.VersionId)
+
+		log.Printf("[DEBUG] Deleting IoT Provisioning Template (%s) version: %d", name, versionID)
+		_, err := conn.DeleteProvisioningTemplateVersionWithContext(ctx, &iot.DeleteProvisioningTemplateVersionInput{
+			TemplateName: aws.String(name),
+			VersionId:    v.VersionId,
+		})
+
+		if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting IoT Provisioning Template (%s) version (%d): %w", name, versionID, err)
+		}
+	}
+
+	return nil
+}
+
+func FindProvisioningTemplateVersionsByName(ctx context.Context, conn *iot.IoT, name string) ([]*iot.ProvisioningTemplateVersionSummary, error) {
+	input := &iot.ListProvisioningTemplateVersionsInput{
+		TemplateName: aws.String(name),
+	}
+	var output []*iot.ProvisioningTemplateVersionSummary
+
+	err := conn.ListProvisioningTemplateVersionsPagesWithContext(ctx, input, func(page *iot.ListProvisioningTemplateVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Versions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindProvisioningTemplateByName(ctx context.Context, conn *iot.IoT, name string) (*iot.DescribeProvisioningTemplateOutput, error) {
 	input := &iot.DescribeProvisioningTemplateInput{
 		TemplateName: aws.String(name),
@@ -8,8 +8,7 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -177,6 +176,84 @@ func TestAccIoTProvisioningTemplate_update(t *testing.T) {
 	})
 }
 
+func TestAccIoTProvisioningTemplate_jitp(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_provisioning_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvisioningTemplateConfig_jitpPreProvisioningHook(rName),
+				ExpectError: regexache.MustCompile(`"pre_provisioning_hook" cannot be specified when type is "JITP"`),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_jitp(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pre_provisioning_hook.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "JITP"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTProvisioningTemplate_previousVersionsToKeep(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_provisioning_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningTemplateConfig_previousVersionsToKeep(rName, "v1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 1),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "previous_versions_to_keep", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"previous_versions_to_keep"},
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_previousVersionsToKeep(rName, "v2", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_previousVersionsToKeep(rName, "v3", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct3),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckProvisioningTemplateExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -226,23 +303,13 @@ func testAccCheckProvisioningTemplateNumVersions(ctx context.Context, name strin
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
 
-		var got int
-		err := conn.ListProvisioningTemplateVersionsPagesWithContext(ctx, &iot.ListProvisioningTemplateVersionsInput{TemplateName: aws.String(name)},
-			func(page *iot.ListProvisioningTemplateVersionsOutput, lastPage bool) bool {
-				if page == nil {
-					return !lastPage
-				}
-
-				got += len(page.Versions)
-
-				return !lastPage
-			})
+		versions, err := tfiot.FindProvisioningTemplateVersionsByName(ctx, conn, name)
 
 		if err != nil {
 			return err
 		}
 
-		if got != want {
+		if got := len(versions); got != want {
 			return fmt.Errorf("Incorrect version count for IoT Provisioning Template %s; got: %d, want: %d", name, got, want)
 		}
 
@@ -428,3 +495,111 @@ resource "aws_iot_provisioning_template" "test" {
 }
 `, rName))
 }
+
+func testAccProvisioningTemplateConfig_jitp(rName string) string {
+	return acctest.ConfigCompose(testAccProvisioningTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = aws_iam_role.test.arn
+  type                  = "JITP"
+
+  template_body = jsonencode({
+    Parameters = {
+      "AWS::IoT::Certificate::CommonName" = { Type = "String" }
+      "AWS::IoT::Certificate::Id"         = { Type = "String" }
+    }
+
+    Resources = {
+      thing = {
+        Properties = {
+          ThingName = { Ref = "AWS::IoT::Certificate::CommonName" }
+        }
+        Type = "AWS::IoT::Thing"
+      }
+
+      certificate = {
+        Properties = {
+          CertificateId = { Ref = "AWS::IoT::Certificate::Id" }
+          Status        = "ACTIVE"
+        }
+        Type = "AWS::IoT::Certificate"
+      }
+
+      policy = {
+        Properties = {
+          PolicyName = aws_iot_policy.test.name
+        }
+        Type = "AWS::IoT::Policy"
+      }
+    }
+  })
+}
+`, rName))
+}
+
+func testAccProvisioningTemplateConfig_jitpPreProvisioningHook(rName string) string {
+	return acctest.ConfigCompose(testAccProvisioningTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = aws_iam_role.test.arn
+  type                  = "JITP"
+
+  pre_provisioning_hook {
+    target_arn = "arn:${data.aws_partition.current.partition}:lambda:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:function:test"
+  }
+
+  template_body = jsonencode({
+    Parameters = {
+      "AWS::IoT::Certificate::Id" = { Type = "String" }
+    }
+
+    Resources = {
+      certificate = {
+        Properties = {
+          CertificateId = { Ref = "AWS::IoT::Certificate::Id" }
+          Status        = "ACTIVE"
+        }
+        Type = "AWS::IoT::Certificate"
+      }
+    }
+  })
+}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+`, rName))
+}
+
+func testAccProvisioningTemplateConfig_previousVersionsToKeep(rName, serialNumber string, previousVersionsToKeep int) string {
+	return acctest.ConfigCompose(testAccProvisioningTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                      = %[1]q
+  provisioning_role_arn     = aws_iam_role.test.arn
+  previous_versions_to_keep = %[3]d
+
+  template_body = jsonencode({
+    Parameters = {
+      SerialNumber = { Type = "String", Default = %[2]q }
+    }
+
+    Resources = {
+      certificate = {
+        Properties = {
+          CertificateId = { Ref = "AWS::IoT::Certificate::Id" }
+          Status        = "Active"
+        }
+        Type = "AWS::IoT::Certificate"
+      }
+
+      policy = {
+        Properties = {
+          PolicyName = aws_iot_policy.test.name
+        }
+        Type = "AWS::IoT::Policy"
+      }
+    }
+  })
+}
+`, rName, serialNumber, previousVersionsToKeep))
+}
@@ -27,6 +27,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceBillingGroup,
+			TypeName: "aws_iot_billing_group",
+			Name:     "Billing Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  DataSourceEndpoint,
 			TypeName: "aws_iot_endpoint",
@@ -36,6 +44,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iot_registration_code",
 			Name:     "Registration Code",
 		},
+		{
+			Factory:  DataSourceThingGroup,
+			TypeName: "aws_iot_thing_group",
+			Name:     "Thing Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  DataSourceThings,
+			TypeName: "aws_iot_things",
+			Name:     "Things",
+		},
 	}
 }
 
@@ -74,6 +95,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceDynamicThingGroup,
+			TypeName: "aws_iot_dynamic_thing_group",
+			Name:     "Dynamic Thing Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceEventConfigurations,
 			TypeName: "aws_iot_event_configurations",
@@ -87,6 +116,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLoggingOptions,
 			TypeName: "aws_iot_logging_options",
 		},
+		{
+			Factory:  ResourceLoggingTarget,
+			TypeName: "aws_iot_logging_target",
+			Name:     "Logging Target",
+		},
 		{
 			Factory:  ResourcePolicy,
 			TypeName: "aws_iot_policy",
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iot_thing_group", name="Thing Group")
+// @Tags(identifierAttribute="arn")
+func DataSourceThingGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceThingGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"include_thing_names": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCreationDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"root_to_parent_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrGroupName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parent_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrProperties: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_payload": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAttributes: {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"recursive": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"include_thing_names"},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"thing_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceThingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	output, err := FindThingGroupByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Thing Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ThingGroupName))
+	d.Set(names.AttrARN, output.ThingGroupArn)
+	if output.ThingGroupMetadata != nil {
+		if err := d.Set("metadata", []interface{}{flattenThingGroupMetadata(output.ThingGroupMetadata)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting metadata: %s", err)
+		}
+		d.Set("parent_group_name", output.ThingGroupMetadata.ParentGroupName)
+	} else {
+		d.Set("metadata", nil)
+		d.Set("parent_group_name", nil)
+	}
+	d.Set(names.AttrName, output.ThingGroupName)
+	if v := flattenThingGroupProperties(output.ThingGroupProperties); len(v) > 0 {
+		if err := d.Set(names.AttrProperties, []interface{}{v}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting properties: %s", err)
+		}
+	} else {
+		d.Set(names.AttrProperties, nil)
+	}
+	d.Set(names.AttrVersion, output.Version)
+
+	if d.Get("include_thing_names").(bool) {
+		thingNames, err := findThingNamesInThingGroup(ctx, conn, name, d.Get("recursive").(bool))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing IoT Thing Group (%s) things: %s", name, err)
+		}
+
+		d.Set("thing_names", thingNames)
+	} else {
+		d.Set("thing_names", nil)
+	}
+
+	return diags
+}
+
+func findThingNamesInThingGroup(ctx context.Context, conn *iot.IoT, thingGroupName string, recursive bool) ([]string, error) {
+	input := &iot.ListThingsInThingGroupInput{
+		Recursive:      aws.Bool(recursive),
+		ThingGroupName: aws.String(thingGroupName),
+	}
+	var output []string
+
+	err := conn.ListThingsInThingGroupPagesWithContext(ctx, input, func(page *iot.ListThingsInThingGroupOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.Things)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
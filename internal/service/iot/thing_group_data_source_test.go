@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTThingGroupDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iot_thing_group.test"
+	resourceName := "aws_iot_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metadata.#", resourceName, "metadata.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metadata.0.creation_date", resourceName, "metadata.0.creation_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metadata.0.root_to_parent_groups.#", resourceName, "metadata.0.root_to_parent_groups.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "parent_group_name", resourceName, "parent_group_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "properties.#", resourceName, "properties.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "properties.0.attribute_payload.0.attributes.%", resourceName, "properties.0.attribute_payload.0.attributes.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "properties.0.description", resourceName, "properties.0.description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsKey1, resourceName, acctest.CtTagsKey1),
+					resource.TestCheckResourceAttr(dataSourceName, "thing_names.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVersion, resourceName, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTThingGroupDataSource_thingNames(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	directDataSourceName := "data.aws_iot_thing_group.direct"
+	recursiveDataSourceName := "data.aws_iot_thing_group.recursive"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupDataSourceConfig_thingNames(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(directDataSourceName, "thing_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(directDataSourceName, "thing_names.*", "aws_iot_thing.parent", names.AttrName),
+					resource.TestCheckResourceAttr(recursiveDataSourceName, "thing_names.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(recursiveDataSourceName, "thing_names.*", "aws_iot_thing.parent", names.AttrName),
+					resource.TestCheckTypeSetElemAttrPair(recursiveDataSourceName, "thing_names.*", "aws_iot_thing.child", names.AttrName),
+					resource.TestCheckResourceAttr("data.aws_iot_thing_group.child", "metadata.0.root_to_parent_groups.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair("data.aws_iot_thing_group.child", "metadata.0.root_to_parent_groups.0.group_name", "aws_iot_thing_group.parent", names.AttrName),
+					resource.TestCheckResourceAttrPair("data.aws_iot_thing_group.child", "parent_group_name", "aws_iot_thing_group.parent", names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccThingGroupDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+
+  properties {
+    description = "test description"
+
+    attribute_payload {
+      attributes = {
+        One = "11111"
+      }
+    }
+  }
+
+  tags = {
+    key1 = "value1"
+  }
+}
+
+data "aws_iot_thing_group" "test" {
+  name = aws_iot_thing_group.test.name
+}
+`, rName)
+}
+
+func testAccThingGroupDataSourceConfig_thingNames(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "aws_iot_thing_group" "child" {
+  name              = "%[1]s-child"
+  parent_group_name = aws_iot_thing_group.parent.name
+}
+
+resource "aws_iot_thing" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "aws_iot_thing" "child" {
+  name = "%[1]s-child"
+}
+
+resource "aws_iot_thing_group_membership" "parent" {
+  thing_name       = aws_iot_thing.parent.name
+  thing_group_name = aws_iot_thing_group.parent.name
+}
+
+resource "aws_iot_thing_group_membership" "child" {
+  thing_name       = aws_iot_thing.child.name
+  thing_group_name = aws_iot_thing_group.child.name
+}
+
+data "aws_iot_thing_group" "direct" {
+  name                = aws_iot_thing_group.parent.name
+  include_thing_names = true
+
+  depends_on = [aws_iot_thing_group_membership.parent, aws_iot_thing_group_membership.child]
+}
+
+data "aws_iot_thing_group" "recursive" {
+  name                = aws_iot_thing_group.parent.name
+  include_thing_names = true
+  recursive           = true
+
+  depends_on = [aws_iot_thing_group_membership.parent, aws_iot_thing_group_membership.child]
+}
+
+data "aws_iot_thing_group" "child" {
+  name = aws_iot_thing_group.child.name
+}
+`, rName)
+}
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	defaultThingsIndexName = "AWS_Things"
+)
+
+// @SDKDataSource("aws_iot_things", name="Things")
+func DataSourceThings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceThingsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"index_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultThingsIndexName,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"query_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"things": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrAttributes: {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"connectivity": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"connected": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"disconnect_reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"timestamp": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"device_defender": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shadow": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thing_group_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"thing_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thing_type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceThingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	indexName := d.Get("index_name").(string)
+	queryString := d.Get("query_string").(string)
+	input := &iot.SearchIndexInput{
+		IndexName:   aws.String(indexName),
+		QueryString: aws.String(queryString),
+	}
+
+	if v, ok := d.GetOk("query_version"); ok {
+		input.QueryVersion = aws.String(v.(string))
+	}
+
+	things, err := findThingDocuments(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "searching IoT Index (%s): %s", indexName, err)
+	}
+
+	var arns, nms []string
+	var tfList []interface{}
+
+	for _, v := range things {
+		name := aws.StringValue(v.ThingName)
+		arn := arn.ARN{
+			AccountID: meta.(*conns.AWSClient).AccountID,
+			Partition: meta.(*conns.AWSClient).Partition,
+			Service:   "iot",
+			Region:    meta.(*conns.AWSClient).Region,
+			Resource:  fmt.Sprintf("thing/%s", name),
+		}.String()
+
+		arns = append(arns, arn)
+		nms = append(nms, name)
+
+		tfMap := flattenThingDocument(v)
+		tfMap[names.AttrARN] = arn
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", indexName, queryString))
+
+	if err := d.Set(names.AttrARNs, arns); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting arns: %s", err)
+	}
+	if err := d.Set(names.AttrNames, nms); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting names: %s", err)
+	}
+	if err := d.Set("things", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting things: %s", err)
+	}
+
+	return diags
+}
+
+func findThingDocuments(ctx context.Context, conn *iot.IoT, input *iot.SearchIndexInput) ([]*iot.ThingDocument, error) {
+	var output []*iot.ThingDocument
+
+	for {
+		page, err := conn.SearchIndexWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.Things {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func flattenThingDocument(apiObject *iot.ThingDocument) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Attributes; v != nil {
+		tfMap[names.AttrAttributes] = aws.StringValueMap(v)
+	}
+
+	if v := apiObject.Connectivity; v != nil {
+		tfMap["connectivity"] = []interface{}{flattenThingConnectivity(v)}
+	}
+
+	if v := apiObject.DeviceDefender; v != nil {
+		tfMap["device_defender"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ThingName; v != nil {
+		tfMap[names.AttrName] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Shadow; v != nil {
+		tfMap["shadow"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ThingGroupNames; v != nil {
+		tfMap["thing_group_names"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ThingId; v != nil {
+		tfMap["thing_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ThingTypeName; v != nil {
+		tfMap["thing_type_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenThingConnectivity(apiObject *iot.ThingConnectivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Connected; v != nil {
+		tfMap["connected"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.DisconnectReason; v != nil {
+		tfMap["disconnect_reason"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Timestamp; v != nil {
+		tfMap["timestamp"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccThingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iot_things.test"
+	thingResourceName := "aws_iot_thing.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", thingResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", thingResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "things.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "things.0.attributes.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "things.0.attributes.stage", "test"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "things.0.name", thingResourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "things.0.thing_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccThingsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_indexing_configuration" "test" {
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+  }
+}
+
+resource "aws_iot_thing" "test" {
+  name = %[1]q
+
+  attributes = {
+    stage = "test"
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+
+data "aws_iot_things" "test" {
+  query_string = "thingName:${aws_iot_thing.test.name}"
+}
+`, rName)
+}
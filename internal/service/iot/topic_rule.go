@@ -71,6 +71,11 @@ func ResourceTopicRule() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"batch_mode": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						names.AttrLogGroupName: {
 							Type:     schema.TypeString,
 							Required: true,
@@ -275,6 +280,11 @@ func ResourceTopicRule() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"batch_mode": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
 									names.AttrLogGroupName: {
 										Type:     schema.TypeString,
 										Required: true,
@@ -643,12 +653,111 @@ func ResourceTopicRule() *schema.Resource {
 							},
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
+						"location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"device_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"latitude": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"longitude": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"timestamp": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrUnit: {
+													Type:     schema.TypeString,
+													Optional: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														"SECONDS",
+														"MILLISECONDS",
+														"MICROSECONDS",
+														"NANOSECONDS",
+													}, false),
+												},
+												names.AttrValue: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"tracker_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
+						},
 						"republish": {
 							Type:     schema.TypeList,
 							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"headers": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrContentType: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"correlation_data": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"message_expiry": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"payload_format_indicator": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"response_topic": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"user_property": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrKey: {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															names.AttrValue: {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									"qos": {
 										Type:         schema.TypeInt,
 										Optional:     true,
@@ -1016,11 +1125,108 @@ func ResourceTopicRule() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validTopicRuleName,
 			},
+			"location": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"latitude": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"longitude": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"timestamp": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrUnit: {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"SECONDS",
+											"MILLISECONDS",
+											"MICROSECONDS",
+											"NANOSECONDS",
+										}, false),
+									},
+									names.AttrValue: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"tracker_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"republish": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrContentType: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"correlation_data": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"message_expiry": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"payload_format_indicator": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"response_topic": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"user_property": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrKey: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												names.AttrValue: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"qos": {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -1209,6 +1415,7 @@ var topicRuleErrorActionExactlyOneOf = []string{
 	"error_action.0.kafka",
 	"error_action.0.kinesis",
 	"error_action.0.lambda",
+	"error_action.0.location",
 	"error_action.0.republish",
 	"error_action.0.s3",
 	"error_action.0.sns",
@@ -1331,6 +1538,10 @@ func resourceTopicRuleRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting lambda: %s", err)
 	}
 
+	if err := d.Set("location", flattenLocationActions(output.Rule.Actions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting location: %s", err)
+	}
+
 	if err := d.Set("republish", flattenRepublishActions(output.Rule.Actions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting republish: %s", err)
 	}
@@ -1448,6 +1659,10 @@ func expandCloudWatchLogsAction(tfList []interface{}) *iot.CloudwatchLogsAction
 	apiObject := &iot.CloudwatchLogsAction{}
 	tfMap := tfList[0].(map[string]interface{})
 
+	if v, ok := tfMap["batch_mode"].(bool); ok {
+		apiObject.BatchMode = aws.Bool(v)
+	}
+
 	if v, ok := tfMap[names.AttrLogGroupName].(string); ok && v != "" {
 		apiObject.LogGroupName = aws.String(v)
 	}
@@ -1805,6 +2020,59 @@ func expandLambdaAction(tfList []interface{}) *iot.LambdaAction {
 	return apiObject
 }
 
+func expandLocationAction(tfList []interface{}) *iot.LocationAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iot.LocationAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["latitude"].(string); ok && v != "" {
+		apiObject.Latitude = aws.String(v)
+	}
+
+	if v, ok := tfMap["longitude"].(string); ok && v != "" {
+		apiObject.Longitude = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Timestamp = expandLocationTimestamp(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["tracker_name"].(string); ok && v != "" {
+		apiObject.TrackerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLocationTimestamp(tfMap map[string]interface{}) *iot.LocationTimestamp {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.LocationTimestamp{}
+
+	if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandRepublishAction(tfList []interface{}) *iot.RepublishAction {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -1813,6 +2081,10 @@ func expandRepublishAction(tfList []interface{}) *iot.RepublishAction {
 	apiObject := &iot.RepublishAction{}
 	tfMap := tfList[0].(map[string]interface{})
 
+	if v, ok := tfMap["headers"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Headers = expandMQTTHeaders(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["qos"].(int); ok {
 		apiObject.Qos = aws.Int64(int64(v))
 	}
@@ -1828,6 +2100,59 @@ func expandRepublishAction(tfList []interface{}) *iot.RepublishAction {
 	return apiObject
 }
 
+func expandMQTTHeaders(tfMap map[string]interface{}) *iot.MqttHeaders {
+	apiObject := &iot.MqttHeaders{}
+
+	if v, ok := tfMap[names.AttrContentType].(string); ok && v != "" {
+		apiObject.ContentType = aws.String(v)
+	}
+
+	if v, ok := tfMap["correlation_data"].(string); ok && v != "" {
+		apiObject.CorrelationData = aws.String(v)
+	}
+
+	if v, ok := tfMap["message_expiry"].(string); ok && v != "" {
+		apiObject.MessageExpiry = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload_format_indicator"].(string); ok && v != "" {
+		apiObject.PayloadFormatIndicator = aws.String(v)
+	}
+
+	if v, ok := tfMap["response_topic"].(string); ok && v != "" {
+		apiObject.ResponseTopic = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_property"].([]interface{}); ok && len(v) > 0 {
+		apiObject.UserProperties = expandUserProperties(v)
+	}
+
+	return apiObject
+}
+
+func expandUserProperties(tfList []interface{}) []*iot.UserProperty {
+	var apiObjects []*iot.UserProperty
+	for _, elem := range tfList {
+		tfMap, ok := elem.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iot.UserProperty{}
+		if v, ok := tfMap[names.AttrKey].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandS3Action(tfList []interface{}) *iot.S3Action {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -2145,6 +2470,17 @@ func expandTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
 		actions = append(actions, &iot.Action{Lambda: action})
 	}
 
+	// Legacy root attribute handling
+	for _, tfMapRaw := range d.Get("location").(*schema.Set).List() {
+		action := expandLocationAction([]interface{}{tfMapRaw})
+
+		if action == nil {
+			continue
+		}
+
+		actions = append(actions, &iot.Action{Location: action})
+	}
+
 	// Legacy root attribute handling
 	for _, tfMapRaw := range d.Get("republish").(*schema.Set).List() {
 		action := expandRepublishAction([]interface{}{tfMapRaw})
@@ -2351,6 +2687,16 @@ func expandTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
 
 					iotErrorAction = &iot.Action{Lambda: action}
 				}
+			case "location":
+				for _, tfMapRaw := range v.([]interface{}) {
+					action := expandLocationAction([]interface{}{tfMapRaw})
+
+					if action == nil {
+						continue
+					}
+
+					iotErrorAction = &iot.Action{Location: action}
+				}
 			case "republish":
 				for _, tfMapRaw := range v.([]interface{}) {
 					action := expandRepublishAction([]interface{}{tfMapRaw})
@@ -2475,6 +2821,10 @@ func flattenCloudWatchLogsAction(apiObject *iot.CloudwatchLogsAction) []interfac
 
 	tfMap := make(map[string]interface{})
 
+	if v := apiObject.BatchMode; v != nil {
+		tfMap["batch_mode"] = aws.BoolValue(v)
+	}
+
 	if v := apiObject.LogGroupName; v != nil {
 		tfMap[names.AttrLogGroupName] = aws.StringValue(v)
 	}
@@ -3033,6 +3383,75 @@ func flattenPutItemInput(apiObject *iot.PutItemInput) []interface{} {
 	return []interface{}{tfMap}
 }
 
+// Legacy root attribute handling
+func flattenLocationActions(actions []*iot.Action) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, action := range actions {
+		if action == nil {
+			continue
+		}
+
+		if v := action.Location; v != nil {
+			results = append(results, flattenLocationAction(v)...)
+		}
+	}
+
+	return results
+}
+
+func flattenLocationAction(apiObject *iot.LocationAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.DeviceId; v != nil {
+		tfMap["device_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Latitude; v != nil {
+		tfMap["latitude"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Longitude; v != nil {
+		tfMap["longitude"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap[names.AttrRoleARN] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Timestamp; v != nil {
+		tfMap["timestamp"] = flattenLocationTimestamp(v)
+	}
+
+	if v := apiObject.TrackerName; v != nil {
+		tfMap["tracker_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLocationTimestamp(apiObject *iot.LocationTimestamp) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.Unit; v != nil {
+		tfMap[names.AttrUnit] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap[names.AttrValue] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 // Legacy root attribute handling
 func flattenRepublishActions(actions []*iot.Action) []interface{} {
 	results := make([]interface{}, 0)
@@ -3057,6 +3476,10 @@ func flattenRepublishAction(apiObject *iot.RepublishAction) []interface{} {
 
 	tfMap := make(map[string]interface{})
 
+	if v := apiObject.Headers; v != nil {
+		tfMap["headers"] = flattenMQTTHeaders(v)
+	}
+
 	if v := apiObject.Qos; v != nil {
 		tfMap["qos"] = aws.Int64Value(v)
 	}
@@ -3072,6 +3495,62 @@ func flattenRepublishAction(apiObject *iot.RepublishAction) []interface{} {
 	return []interface{}{tfMap}
 }
 
+func flattenMQTTHeaders(apiObject *iot.MqttHeaders) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.ContentType; v != nil {
+		tfMap[names.AttrContentType] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CorrelationData; v != nil {
+		tfMap["correlation_data"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MessageExpiry; v != nil {
+		tfMap["message_expiry"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PayloadFormatIndicator; v != nil {
+		tfMap["payload_format_indicator"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResponseTopic; v != nil {
+		tfMap["response_topic"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UserProperties; v != nil {
+		tfMap["user_property"] = flattenUserProperties(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUserProperties(apiObjects []*iot.UserProperty) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, apiObject := range apiObjects {
+		if apiObject != nil {
+			tfMap := make(map[string]interface{})
+
+			if v := apiObject.Key; v != nil {
+				tfMap[names.AttrKey] = aws.StringValue(v)
+			}
+
+			if v := apiObject.Value; v != nil {
+				tfMap[names.AttrValue] = aws.StringValue(v)
+			}
+
+			results = append(results, tfMap)
+		}
+	}
+
+	return results
+}
+
 // Legacy root attribute handling
 func flattenS3Actions(actions []*iot.Action) []interface{} {
 	results := make([]interface{}, 0)
@@ -3382,6 +3861,10 @@ func flattenErrorAction(errorAction *iot.Action) []map[string]interface{} {
 		results = append(results, map[string]interface{}{"lambda": flattenLambdaActions(input)})
 		return results
 	}
+	if errorAction.Location != nil {
+		results = append(results, map[string]interface{}{"location": flattenLocationActions(input)})
+		return results
+	}
 	if errorAction.Republish != nil {
 		results = append(results, map[string]interface{}{"republish": flattenRepublishActions(input)})
 		return results
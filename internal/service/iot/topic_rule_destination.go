@@ -6,6 +6,7 @@ package iot
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -46,14 +48,51 @@ func ResourceTopicRuleDestination() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"confirmation_retry_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"confirmation_retry_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "5m",
+				ValidateFunc: verify.ValidDuration,
+			},
 			names.AttrEnabled: {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
+			"http_url_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"confirmation_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"http_url_configuration", names.AttrVPCConfiguration},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusReason: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrVPCConfiguration: {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -83,6 +122,12 @@ func ResourceTopicRuleDestination() *schema.Resource {
 						},
 					},
 				},
+				ExactlyOneOf: []string{"http_url_configuration", names.AttrVPCConfiguration},
+			},
+			"wait_for_confirmation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -97,6 +142,10 @@ func resourceTopicRuleDestinationCreate(ctx context.Context, d *schema.ResourceD
 		DestinationConfiguration: &iot.TopicRuleDestinationConfiguration{},
 	}
 
+	if v, ok := d.GetOk("http_url_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DestinationConfiguration.HttpUrlConfiguration = expandHTTPURLDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrVPCConfiguration); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.DestinationConfiguration.VpcConfiguration = expandVPCDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -122,8 +171,20 @@ func resourceTopicRuleDestinationCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(aws.StringValue(outputRaw.(*iot.CreateTopicRuleDestinationOutput).TopicRuleDestination.Arn))
 
-	if _, err := waitTopicRuleDestinationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for IoT Topic Rule Destination (%s) create: %s", d.Id(), err)
+	if input.DestinationConfiguration.HttpUrlConfiguration != nil {
+		// HTTP destinations remain IN_PROGRESS until the endpoint confirms ownership of the URL.
+		if !d.Get("wait_for_confirmation").(bool) {
+			return append(diags, resourceTopicRuleDestinationRead(ctx, d, meta)...)
+		}
+
+		retryInterval, _ := time.ParseDuration(d.Get("confirmation_retry_interval").(string))
+		if _, err := waitTopicRuleDestinationConfirmed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate), d.Get("confirmation_retry_attempts").(int), retryInterval); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Topic Rule Destination (%s) confirmation: %s", d.Id(), err)
+		}
+	} else {
+		if _, err := waitTopicRuleDestinationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Topic Rule Destination (%s) create: %s", d.Id(), err)
+		}
 	}
 
 	if _, ok := d.GetOk(names.AttrEnabled); !ok {
@@ -162,7 +223,19 @@ func resourceTopicRuleDestinationRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.Set(names.AttrARN, output.Arn)
-	d.Set(names.AttrEnabled, aws.StringValue(output.Status) == iot.TopicRuleDestinationStatusEnabled)
+	// An unconfirmed destination is neither enabled nor disabled.
+	if status := aws.StringValue(output.Status); status != iot.TopicRuleDestinationStatusInProgress {
+		d.Set(names.AttrEnabled, status == iot.TopicRuleDestinationStatusEnabled)
+	}
+	if output.HttpUrlProperties != nil {
+		if err := d.Set("http_url_configuration", []interface{}{flattenHTTPURLDestinationProperties(output.HttpUrlProperties)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting http_url_configuration: %s", err)
+		}
+	} else {
+		d.Set("http_url_configuration", nil)
+	}
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrStatusReason, output.StatusReason)
 	if output.VpcProperties != nil {
 		if err := d.Set(names.AttrVPCConfiguration, []interface{}{flattenVPCDestinationProperties(output.VpcProperties)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting vpc_configuration: %s", err)
@@ -226,6 +299,20 @@ func resourceTopicRuleDestinationDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+func expandHTTPURLDestinationConfiguration(tfMap map[string]interface{}) *iot.HttpUrlDestinationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.HttpUrlDestinationConfiguration{}
+
+	if v, ok := tfMap["confirmation_url"].(string); ok && v != "" {
+		apiObject.ConfirmationUrl = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandVPCDestinationConfiguration(tfMap map[string]interface{}) *iot.VpcDestinationConfiguration {
 	if tfMap == nil {
 		return nil
@@ -252,6 +339,20 @@ func expandVPCDestinationConfiguration(tfMap map[string]interface{}) *iot.VpcDes
 	return apiObject
 }
 
+func flattenHTTPURLDestinationProperties(apiObject *iot.HttpUrlDestinationProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConfirmationUrl; v != nil {
+		tfMap["confirmation_url"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func flattenVPCDestinationProperties(apiObject *iot.VpcDestinationProperties) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -313,6 +414,61 @@ func waitTopicRuleDestinationCreated(ctx context.Context, conn *iot.IoT, arn str
 	return nil, err
 }
 
+func waitTopicRuleDestinationConfirmedOnce(ctx context.Context, conn *iot.IoT, arn string, timeout time.Duration) (*iot.TopicRuleDestination, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iot.TopicRuleDestinationStatusInProgress},
+		Target:  []string{iot.TopicRuleDestinationStatusEnabled, iot.TopicRuleDestinationStatusDisabled},
+		Refresh: statusTopicRuleDestination(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iot.TopicRuleDestination); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitTopicRuleDestinationConfirmed waits for an HTTP destination to be confirmed.
+// If the destination is still unconfirmed after retryInterval, or confirmation failed,
+// a new confirmation message is sent to the endpoint, up to retryAttempts times.
+func waitTopicRuleDestinationConfirmed(ctx context.Context, conn *iot.IoT, arn string, timeout time.Duration, retryAttempts int, retryInterval time.Duration) (*iot.TopicRuleDestination, error) {
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 0; ; attempt++ {
+		remaining := time.Until(deadline)
+		wait := remaining
+		if attempt < retryAttempts && retryInterval < remaining {
+			wait = retryInterval
+		}
+
+		output, err := waitTopicRuleDestinationConfirmedOnce(ctx, conn, arn, wait)
+
+		if err == nil {
+			return output, nil
+		}
+
+		var unexpectedStateErr *retry.UnexpectedStateError
+		if attempt >= retryAttempts || time.Until(deadline) <= 0 || !(tfresource.TimedOut(err) || (errors.As(err, &unexpectedStateErr) && unexpectedStateErr.State == iot.TopicRuleDestinationStatusError)) {
+			return output, err
+		}
+
+		log.Printf("[DEBUG] Resending IoT Topic Rule Destination (%s) confirmation (attempt %d of %d)", arn, attempt+1, retryAttempts)
+		_, err = conn.UpdateTopicRuleDestinationWithContext(ctx, &iot.UpdateTopicRuleDestinationInput{
+			Arn:    aws.String(arn),
+			Status: aws.String(iot.TopicRuleDestinationStatusInProgress),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("resending confirmation: %w", err)
+		}
+	}
+}
+
 func waitTopicRuleDestinationDeleted(ctx context.Context, conn *iot.IoT, arn string, timeout time.Duration) (*iot.TopicRuleDestination, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{iot.TopicRuleDestinationStatusDeleting},
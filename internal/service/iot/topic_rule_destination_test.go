@@ -36,6 +36,8 @@ func TestAccIoTTopicRuleDestination_basic(t *testing.T) {
 					testAccCheckTopicRuleDestinationExists(ctx, resourceName),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iot", regexache.MustCompile(`ruledestination/vpc/.+`)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "http_url_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.#", acctest.Ct1),
 					resource.TestCheckResourceAttrSet(resourceName, "vpc_configuration.0.role_arn"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.security_groups.#", acctest.Ct1),
@@ -44,9 +46,10 @@ func TestAccIoTTopicRuleDestination_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirmation_retry_attempts", "confirmation_retry_interval", "wait_for_confirmation"},
 			},
 			// Delete everything but the IAM Role assumed by the IoT service.
 			{
@@ -98,9 +101,10 @@ func TestAccIoTTopicRuleDestination_enabled(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirmation_retry_attempts", "confirmation_retry_interval", "wait_for_confirmation"},
 			},
 			{
 				Config: testAccTopicRuleDestinationConfig_enabled(rName, true),
@@ -124,6 +128,39 @@ func TestAccIoTTopicRuleDestination_enabled(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRuleDestination_httpURL(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iot_topic_rule_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The endpoint never confirms, so don't wait for confirmation.
+				Config: testAccTopicRuleDestinationConfig_httpURL("https://example.com/confirm"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleDestinationExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iot", regexache.MustCompile(`ruledestination/http/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "http_url_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "http_url_configuration.0.confirmation_url", "https://example.com/confirm"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "IN_PROGRESS"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_confirmation", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirmation_retry_attempts", "confirmation_retry_interval", names.AttrEnabled, "wait_for_confirmation"},
+			},
+		},
+	})
+}
+
 func testAccCheckTopicRuleDestinationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
@@ -212,3 +249,15 @@ resource "aws_iot_topic_rule_destination" "test" {
 }
 `, enabled))
 }
+
+func testAccTopicRuleDestinationConfig_httpURL(confirmationURL string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_topic_rule_destination" "test" {
+  wait_for_confirmation = false
+
+  http_url_configuration {
+    confirmation_url = %[1]q
+  }
+}
+`, confirmationURL)
+}
@@ -319,6 +319,56 @@ func TestAccIoTTopicRule_cloudWatchLogs(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_CloudWatchLogs_batch_mode(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_cloudWatchLogsBatchMode(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cloudwatch_logs.*", map[string]string{
+						names.AttrLogGroupName: "mylogs",
+						"batch_mode":           acctest.CtFalse,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "error_action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.cloudwatch_logs.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.cloudwatch_logs.0.log_group_name", "myerrorlogs"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.cloudwatch_logs.0.batch_mode", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicRuleConfig_cloudWatchLogsBatchMode(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logs.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cloudwatch_logs.*", map[string]string{
+						names.AttrLogGroupName: "mylogs",
+						"batch_mode":           acctest.CtTrue,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "error_action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.cloudwatch_logs.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.cloudwatch_logs.0.log_group_name", "myerrorlogs"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.cloudwatch_logs.0.batch_mode", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIoTTopicRule_cloudWatchMetric(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := testAccTopicRuleName()
@@ -1490,6 +1540,42 @@ func TestAccIoTTopicRule_kafka(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_Kafka_errorAction(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_kafkaErrorAction(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "error_action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.0.topic", "error_topic"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.0.header.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.0.header.0.key", "header-1"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.0.header.0.value", "value-1"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.0.header.1.key", "header-2"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.0.header.1.value", "${topic()}"),
+					resource.TestCheckResourceAttr(resourceName, "kafka.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccIoTTopicRule_kinesis(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := testAccTopicRuleName()
@@ -1614,6 +1700,48 @@ func TestAccIoTTopicRule_lambda(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_location(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_location(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "error_action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.location.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.location.0.tracker_name", "error-tracker"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.location.0.timestamp.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "lambda.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "location.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "location.*", map[string]string{
+						"device_id":         "${deviceId}",
+						"latitude":          "${lat}",
+						"longitude":         "${long}",
+						"timestamp.#":       acctest.Ct1,
+						"timestamp.0.unit":  "SECONDS",
+						"timestamp.0.value": "${timestamp()}",
+						"tracker_name":      "tracker",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "republish.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccIoTTopicRule_republish(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := testAccTopicRuleName()
@@ -1744,6 +1872,47 @@ func TestAccIoTTopicRule_republishWithQos(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_republishWithHeaders(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_republishHeaders(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "republish.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "republish.*", map[string]string{
+						"headers.#":                          acctest.Ct1,
+						"headers.0.content_type":             "application/json",
+						"headers.0.correlation_data":         "${encode(correlationData, 'base64')}",
+						"headers.0.message_expiry":           "${topic(3)}",
+						"headers.0.payload_format_indicator": "UTF8_DATA",
+						"headers.0.response_topic":           "${topic(3)}/response",
+						"headers.0.user_property.#":          acctest.Ct2,
+						"headers.0.user_property.0.key":      "source",
+						"headers.0.user_property.0.value":    "${topic()}",
+						"headers.0.user_property.1.key":      "rule",
+						"headers.0.user_property.1.value":    rName,
+						"topic":                              "mytopic",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccIoTTopicRule_s3(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := testAccTopicRuleName()
@@ -2517,6 +2686,33 @@ resource "aws_iot_topic_rule" "test" {
 `, rName, logGroupName))
 }
 
+func testAccTopicRuleConfig_cloudWatchLogsBatchMode(rName string, batchMode bool) string {
+	return acctest.ConfigCompose(
+		testAccTopicRuleConfig_destinationRole(rName),
+		fmt.Sprintf(`
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  cloudwatch_logs {
+    log_group_name = "mylogs"
+    role_arn       = aws_iam_role.test.arn
+    batch_mode     = %[2]t
+  }
+
+  error_action {
+    cloudwatch_logs {
+      log_group_name = "myerrorlogs"
+      role_arn       = aws_iam_role.test.arn
+      batch_mode     = %[2]t
+    }
+  }
+}
+`, rName, batchMode))
+}
+
 func testAccTopicRuleConfig_cloudWatchMetric(rName string, metricName string) string {
 	return acctest.ConfigCompose(
 		testAccTopicRuleConfig_destinationRole(rName),
@@ -2895,6 +3091,60 @@ resource "aws_iot_topic_rule" "test" {
 `, rName, topic, broker))
 }
 
+func testAccTopicRuleConfig_kafkaErrorAction(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTopicRuleConfig_destinationRole(rName),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  kafka {
+    destination_arn = "arn:${data.aws_partition.current.partition}:iot:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:ruledestination/vpc/pretend-this-is-a-uuid"
+    topic           = "fake_topic"
+
+    client_properties = {
+      "acks"              = "1"
+      "bootstrap.servers" = "b-1.localhost:9094"
+      "key.serializer"    = "org.apache.kafka.common.serialization.StringSerializer"
+      "security.protocol" = "SSL"
+      "value.serializer"  = "org.apache.kafka.common.serialization.ByteBufferSerializer"
+    }
+  }
+
+  error_action {
+    kafka {
+      destination_arn = "arn:${data.aws_partition.current.partition}:iot:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:ruledestination/vpc/pretend-this-is-a-uuid"
+      topic           = "error_topic"
+
+      client_properties = {
+        "acks"              = "1"
+        "bootstrap.servers" = "b-1.localhost:9094"
+        "key.serializer"    = "org.apache.kafka.common.serialization.StringSerializer"
+        "security.protocol" = "SSL"
+        "value.serializer"  = "org.apache.kafka.common.serialization.ByteBufferSerializer"
+      }
+
+      header {
+        key   = "header-1"
+        value = "value-1"
+      }
+
+      header {
+        key   = "header-2"
+        value = "$${topic()}"
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccTopicRuleConfig_kinesis(rName string, streamName string) string {
 	return acctest.ConfigCompose(
 		testAccTopicRuleConfig_destinationRole(rName),
@@ -2932,6 +3182,42 @@ resource "aws_iot_topic_rule" "test" {
 `, rName)
 }
 
+func testAccTopicRuleConfig_location(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTopicRuleConfig_destinationRole(rName),
+		fmt.Sprintf(`
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  location {
+    device_id    = "$${deviceId}"
+    latitude     = "$${lat}"
+    longitude    = "$${long}"
+    role_arn     = aws_iam_role.test.arn
+    tracker_name = "tracker"
+
+    timestamp {
+      unit  = "SECONDS"
+      value = "$${timestamp()}"
+    }
+  }
+
+  error_action {
+    location {
+      device_id    = "$${deviceId}"
+      latitude     = "$${lat}"
+      longitude    = "$${long}"
+      role_arn     = aws_iam_role.test.arn
+      tracker_name = "error-tracker"
+    }
+  }
+}
+`, rName))
+}
+
 func testAccTopicRuleConfig_republish(rName string, topic string) string {
 	return acctest.ConfigCompose(
 		testAccTopicRuleConfig_destinationRole(rName),
@@ -2969,6 +3255,42 @@ resource "aws_iot_topic_rule" "test" {
 `, rName))
 }
 
+func testAccTopicRuleConfig_republishHeaders(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTopicRuleConfig_destinationRole(rName),
+		fmt.Sprintf(`
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  republish {
+    role_arn = aws_iam_role.test.arn
+    topic    = "mytopic"
+
+    headers {
+      content_type             = "application/json"
+      correlation_data         = "$${encode(correlationData, 'base64')}"
+      message_expiry           = "$${topic(3)}"
+      payload_format_indicator = "UTF8_DATA"
+      response_topic           = "$${topic(3)}/response"
+
+      user_property {
+        key   = "source"
+        value = "$${topic()}"
+      }
+
+      user_property {
+        key   = "rule"
+        value = %[1]q
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccTopicRuleConfig_s3(rName string, bucketName string) string {
 	return acctest.ConfigCompose(
 		testAccTopicRuleConfig_destinationRole(rName),
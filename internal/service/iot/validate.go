@@ -4,11 +4,13 @@
 package iot
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func validThingTypeDescription(v interface{}, k string) (ws []string, errors []error) {
@@ -101,3 +103,103 @@ func validTopicRuleName(v interface{}, s string) ([]string, []error) {
 
 	return nil, nil
 }
+
+var (
+	policyActionRegex   = regexache.MustCompile(`^(\*|[0-9a-z-]+:[0-9A-Za-z*?]+)$`)
+	policyResourceRegex = regexache.MustCompile(`^(\*|arn:.+)$`)
+)
+
+// validPolicyDocument validates an IoT Core policy document. In addition to the
+// checks made on IAM policy documents, each statement must have a valid effect,
+// actions of the form "service:Action" and resources that are "*" or ARNs.
+func validPolicyDocument(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = verify.ValidIAMPolicyJSON(v, k)
+	if len(errors) > 0 {
+		return //nolint:nakedret // Naked return due to legacy, non-idiomatic Go function, error handling
+	}
+
+	var doc struct {
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(v.(string)), &doc); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid IoT policy: %w", k, err))
+		return //nolint:nakedret // Naked return due to legacy, non-idiomatic Go function, error handling
+	}
+
+	var statements []map[string]interface{}
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var statement map[string]interface{}
+		if err := json.Unmarshal(doc.Statement, &statement); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid IoT policy: Statement must be an object or a list of objects", k))
+			return //nolint:nakedret // Naked return due to legacy, non-idiomatic Go function, error handling
+		}
+		statements = append(statements, statement)
+	}
+
+	if len(statements) == 0 {
+		errors = append(errors, fmt.Errorf("%q contains an invalid IoT policy: no statements", k))
+		return //nolint:nakedret // Naked return due to legacy, non-idiomatic Go function, error handling
+	}
+
+	for i, statement := range statements {
+		if effect := statement["Effect"]; effect != "Allow" && effect != "Deny" {
+			errors = append(errors, fmt.Errorf("%q contains an invalid IoT policy: statement %d: Effect must be Allow or Deny", k, i))
+		}
+
+		if _, ok := statement["Action"]; !ok {
+			if _, ok := statement["NotAction"]; !ok {
+				errors = append(errors, fmt.Errorf("%q contains an invalid IoT policy: statement %d: Action or NotAction is required", k, i))
+			}
+		}
+
+		for _, key := range []string{"Action", "NotAction"} {
+			for _, value := range policyStatementElementValues(statement, key) {
+				if !policyActionRegex.MatchString(value) {
+					errors = append(errors, fmt.Errorf("%q contains an invalid IoT policy: statement %d: %s %q must be \"*\" or of the form \"service:Action\", such as \"iot:Connect\"", k, i, key, value))
+				}
+			}
+		}
+
+		if _, ok := statement["Resource"]; !ok {
+			if _, ok := statement["NotResource"]; !ok {
+				errors = append(errors, fmt.Errorf("%q contains an invalid IoT policy: statement %d: Resource or NotResource is required", k, i))
+			}
+		}
+
+		for _, key := range []string{"Resource", "NotResource"} {
+			for _, value := range policyStatementElementValues(statement, key) {
+				if !policyResourceRegex.MatchString(value) {
+					errors = append(errors, fmt.Errorf("%q contains an invalid IoT policy: statement %d: %s %q must be \"*\" or an ARN", k, i, key, value))
+				}
+			}
+		}
+	}
+
+	return //nolint:nakedret // Naked return due to legacy, non-idiomatic Go function, error handling
+}
+
+// policyStatementElementValues returns the values of a policy statement element
+// that is either a string or a list of strings. Values of any other type are
+// returned as their JSON encoding so that they fail subsequent format checks.
+func policyStatementElementValues(statement map[string]interface{}, key string) []string {
+	switch v := statement[key].(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{v}
+	case []interface{}:
+		var values []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				values = append(values, v)
+			} else {
+				b, _ := json.Marshal(v)
+				values = append(values, string(b))
+			}
+		}
+		return values
+	default:
+		b, _ := json.Marshal(v)
+		return []string{string(b)}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	validPolicies := []string{
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"iot:*","Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":{"Effect":"Deny","Action":["iot:Connect","iot:Publish"],"Resource":["arn:aws:iot:us-west-2:123456789012:client/${iot:Connection.Thing.ThingName}"]}}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"greengrass:*","NotResource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"Bool":{"iot:Connection.Thing.IsAttached":"true"}}}]}`,
+	}
+	for _, v := range validPolicies {
+		_, errors := validPolicyDocument(v, names.AttrPolicy)
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IoT policy: %q", v, errors)
+		}
+	}
+
+	invalidPolicies := []string{
+		``,
+		`not json`,
+		`["iot:*"]`,
+		`{"Version":"2012-10-17"}`,
+		`{"Version":"2012-10-17","Statement":[]}`,
+		`{"Version":"2012-10-17","Statement":"iot:*"}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Permit","Action":"iot:*","Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"Connect","Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["iot:Connect",1],"Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"iot:*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"iot:*","Resource":"client/test"}]}`,
+	}
+	for _, v := range invalidPolicies {
+		_, errors := validPolicyDocument(v, names.AttrPolicy)
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IoT policy", v)
+		}
+	}
+}
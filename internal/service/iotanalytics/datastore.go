@@ -0,0 +1,572 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_datastore", name="Datastore")
+// @Tags(identifierAttribute="arn")
+func ResourceDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatastoreCreate,
+		ReadWithoutTimeout:   resourceDatastoreRead,
+		UpdateWithoutTimeout: resourceDatastoreUpdate,
+		DeleteWithoutTimeout: resourceDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_partitions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 25,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_partition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+											},
+										},
+									},
+									"timestamp_partition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"timestamp_format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"datastore_storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_s3": customerManagedS3Schema(),
+						"service_managed_s3":  serviceManagedS3Schema(),
+					},
+				},
+			},
+			"file_format_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"json_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+						"parquet_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"schema_definition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 100,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrName: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+															names.AttrType: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 131072),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			names.AttrTags:     tftags.TagsSchema(),
+			names.AttrTagsAll:  tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName: aws.String(name),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("datastore_partitions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DatastorePartitions = expandDatastorePartitions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("datastore_storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DatastoreStorage = expandDatastoreStorage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("file_format_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FileFormatConfiguration = expandFileFormatConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateDatastoreWithContext(ctx, input)
+	}, iotanalytics.ErrCodeInvalidRequestException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Analytics Datastore (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceDatastoreRead(ctx, d, meta)...)
+}
+
+func resourceDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	output, err := FindDatastoreByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	if output.DatastorePartitions != nil && len(output.DatastorePartitions.Partitions) > 0 {
+		if err := d.Set("datastore_partitions", []interface{}{flattenDatastorePartitions(output.DatastorePartitions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting datastore_partitions: %s", err)
+		}
+	} else {
+		d.Set("datastore_partitions", nil)
+	}
+	if output.Storage != nil {
+		if err := d.Set("datastore_storage", []interface{}{flattenDatastoreStorage(output.Storage)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting datastore_storage: %s", err)
+		}
+	} else {
+		d.Set("datastore_storage", nil)
+	}
+	if output.FileFormatConfiguration != nil {
+		if err := d.Set("file_format_configuration", []interface{}{flattenFileFormatConfiguration(output.FileFormatConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting file_format_configuration: %s", err)
+		}
+	} else {
+		d.Set("file_format_configuration", nil)
+	}
+	d.Set(names.AttrName, output.Name)
+	if output.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenRetentionPeriod(output.RetentionPeriod)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting retention_period: %s", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+
+	return diags
+}
+
+func resourceDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("datastore_storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DatastoreStorage = expandDatastoreStorage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("file_format_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.FileFormatConfiguration = expandFileFormatConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.UpdateDatastoreWithContext(ctx, input)
+		}, iotanalytics.ErrCodeInvalidRequestException, "role")
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Analytics Datastore (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDatastoreRead(ctx, d, meta)...)
+}
+
+func resourceDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Datastore: %s", d.Id())
+	_, err := conn.DeleteDatastoreWithContext(ctx, &iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDatastoreByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Datastore, error) {
+	input := &iotanalytics.DescribeDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatastoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Datastore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Datastore, nil
+}
+
+func expandDatastoreStorage(tfMap map[string]interface{}) *iotanalytics.DatastoreStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.DatastoreStorage{}
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CustomerManagedS3 = &iotanalytics.CustomerManagedDatastoreS3Storage{
+			Bucket:  aws.String(tfMap[names.AttrBucket].(string)),
+			RoleArn: aws.String(tfMap[names.AttrRoleARN].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.CustomerManagedS3.KeyPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["service_managed_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ServiceManagedS3 = &iotanalytics.ServiceManagedDatastoreS3Storage{}
+	}
+
+	return apiObject
+}
+
+func flattenDatastoreStorage(apiObject *iotanalytics.DatastoreStorage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedS3; v != nil {
+		tfMap["customer_managed_s3"] = []interface{}{map[string]interface{}{
+			names.AttrBucket:  aws.StringValue(v.Bucket),
+			"key_prefix":      aws.StringValue(v.KeyPrefix),
+			names.AttrRoleARN: aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if apiObject.ServiceManagedS3 != nil {
+		tfMap["service_managed_s3"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return tfMap
+}
+
+func expandFileFormatConfiguration(tfMap map[string]interface{}) *iotanalytics.FileFormatConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.FileFormatConfiguration{}
+
+	if v, ok := tfMap["json_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.JsonConfiguration = &iotanalytics.JsonConfiguration{}
+	}
+
+	if v, ok := tfMap["parquet_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ParquetConfiguration = &iotanalytics.ParquetConfiguration{}
+
+		if v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["schema_definition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.ParquetConfiguration.SchemaDefinition = expandSchemaDefinition(v[0].(map[string]interface{}))
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandSchemaDefinition(tfMap map[string]interface{}) *iotanalytics.SchemaDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.SchemaDefinition{}
+
+	if v, ok := tfMap["column"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Columns = append(apiObject.Columns, &iotanalytics.Column{
+				Name: aws.String(tfMap[names.AttrName].(string)),
+				Type: aws.String(tfMap[names.AttrType].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenFileFormatConfiguration(apiObject *iotanalytics.FileFormatConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.JsonConfiguration != nil {
+		tfMap["json_configuration"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.ParquetConfiguration; v != nil {
+		tfMapParquet := map[string]interface{}{}
+
+		if v := v.SchemaDefinition; v != nil {
+			var tfList []interface{}
+
+			for _, v := range v.Columns {
+				if v == nil {
+					continue
+				}
+
+				tfList = append(tfList, map[string]interface{}{
+					names.AttrName: aws.StringValue(v.Name),
+					names.AttrType: aws.StringValue(v.Type),
+				})
+			}
+
+			tfMapParquet["schema_definition"] = []interface{}{map[string]interface{}{
+				"column": tfList,
+			}}
+		}
+
+		tfMap["parquet_configuration"] = []interface{}{tfMapParquet}
+	}
+
+	return tfMap
+}
+
+func expandDatastorePartitions(tfMap map[string]interface{}) *iotanalytics.DatastorePartitions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.DatastorePartitions{}
+
+	if v, ok := tfMap["partition"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			partition := &iotanalytics.DatastorePartition{}
+
+			if v, ok := tfMap["attribute_partition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				partition.AttributePartition = &iotanalytics.Partition{
+					AttributeName: aws.String(tfMap["attribute_name"].(string)),
+				}
+			}
+
+			if v, ok := tfMap["timestamp_partition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				partition.TimestampPartition = &iotanalytics.TimestampPartition{
+					AttributeName: aws.String(tfMap["attribute_name"].(string)),
+				}
+
+				if v, ok := tfMap["timestamp_format"].(string); ok && v != "" {
+					partition.TimestampPartition.TimestampFormat = aws.String(v)
+				}
+			}
+
+			apiObject.Partitions = append(apiObject.Partitions, partition)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDatastorePartitions(apiObject *iotanalytics.DatastorePartitions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Partitions {
+		if v == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := v.AttributePartition; v != nil {
+			tfMap["attribute_partition"] = []interface{}{map[string]interface{}{
+				"attribute_name": aws.StringValue(v.AttributeName),
+			}}
+		}
+
+		if v := v.TimestampPartition; v != nil {
+			tfMap["timestamp_partition"] = []interface{}{map[string]interface{}{
+				"attribute_name":   aws.StringValue(v.AttributeName),
+				"timestamp_format": aws.StringValue(v.TimestampFormat),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return map[string]interface{}{
+		"partition": tfList,
+	}
+}
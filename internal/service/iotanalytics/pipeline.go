@@ -0,0 +1,606 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_pipeline", name="Pipeline")
+// @Tags(identifierAttribute="arn")
+func ResourcePipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipelineCreate,
+		ReadWithoutTimeout:   resourcePipelineRead,
+		UpdateWithoutTimeout: resourcePipelineUpdate,
+		DeleteWithoutTimeout: resourcePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAttributes: {
+										Type:     schema.TypeMap,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									names.AttrName: activityNameSchema(),
+									"next":         activityNextSchema(),
+								},
+							},
+						},
+						"channel": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+									names.AttrName: activityNameSchema(),
+									"next":         activityNextSchema(),
+								},
+							},
+						},
+						"datastore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+									names.AttrName: activityNameSchema(),
+								},
+							},
+						},
+						"device_registry_enrich": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: deviceEnrichActivitySchema(),
+							},
+						},
+						"device_shadow_enrich": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: deviceEnrichActivitySchema(),
+							},
+						},
+						names.AttrFilter: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrFilter: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									names.AttrName: activityNameSchema(),
+									"next":         activityNextSchema(),
+								},
+							},
+						},
+						"lambda": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"batch_size": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+									"lambda_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									names.AttrName: activityNameSchema(),
+									"next":         activityNextSchema(),
+								},
+							},
+						},
+						"math": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"math": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									names.AttrName: activityNameSchema(),
+									"next":         activityNextSchema(),
+								},
+							},
+						},
+						"remove_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: attributeListActivitySchema(),
+							},
+						},
+						"select_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: attributeListActivitySchema(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func activityNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+}
+
+func activityNextSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+}
+
+func attributeListActivitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		names.AttrAttributes: {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 50,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+		names.AttrName: activityNameSchema(),
+		"next":         activityNextSchema(),
+	}
+}
+
+func deviceEnrichActivitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"attribute": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+		names.AttrName: activityNameSchema(),
+		"next":         activityNextSchema(),
+		names.AttrRoleARN: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		"thing_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+	}
+}
+
+func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotanalytics.CreatePipelineInput{
+		PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+		PipelineName:       aws.String(name),
+		Tags:               getTagsIn(ctx),
+	}
+
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreatePipelineWithContext(ctx, input)
+	}, iotanalytics.ErrCodeInvalidRequestException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Analytics Pipeline (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourcePipelineRead(ctx, d, meta)...)
+}
+
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	output, err := FindPipelineByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("activity", flattenPipelineActivities(output.Activities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting activity: %s", err)
+	}
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrName, output.Name)
+
+	return diags
+}
+
+func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotanalytics.UpdatePipelineInput{
+			PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+			PipelineName:       aws.String(d.Id()),
+		}
+
+		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.UpdatePipelineWithContext(ctx, input)
+		}, iotanalytics.ErrCodeInvalidRequestException, "role")
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Analytics Pipeline (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePipelineRead(ctx, d, meta)...)
+}
+
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Pipeline: %s", d.Id())
+	_, err := conn.DeletePipelineWithContext(ctx, &iotanalytics.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindPipelineByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Pipeline, error) {
+	input := &iotanalytics.DescribePipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.DescribePipelineWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Pipeline, nil
+}
+
+func expandPipelineActivities(tfList []interface{}) []*iotanalytics.PipelineActivity {
+	var apiObjects []*iotanalytics.PipelineActivity
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.PipelineActivity{}
+
+		if v, ok := tfMap["add_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.AddAttributes = &iotanalytics.AddAttributesActivity{
+				Attributes: flex.ExpandStringMap(tfMap[names.AttrAttributes].(map[string]interface{})),
+				Name:       aws.String(tfMap[names.AttrName].(string)),
+				Next:       expandActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["channel"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Channel = &iotanalytics.ChannelActivity{
+				ChannelName: aws.String(tfMap["channel_name"].(string)),
+				Name:        aws.String(tfMap[names.AttrName].(string)),
+				Next:        expandActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["datastore"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Datastore = &iotanalytics.DatastoreActivity{
+				DatastoreName: aws.String(tfMap["datastore_name"].(string)),
+				Name:          aws.String(tfMap[names.AttrName].(string)),
+			}
+		}
+
+		if v, ok := tfMap["device_registry_enrich"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.DeviceRegistryEnrich = &iotanalytics.DeviceRegistryEnrichActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Name:      aws.String(tfMap[names.AttrName].(string)),
+				Next:      expandActivityNext(tfMap),
+				RoleArn:   aws.String(tfMap[names.AttrRoleARN].(string)),
+				ThingName: aws.String(tfMap["thing_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["device_shadow_enrich"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.DeviceShadowEnrich = &iotanalytics.DeviceShadowEnrichActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Name:      aws.String(tfMap[names.AttrName].(string)),
+				Next:      expandActivityNext(tfMap),
+				RoleArn:   aws.String(tfMap[names.AttrRoleARN].(string)),
+				ThingName: aws.String(tfMap["thing_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap[names.AttrFilter].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Filter = &iotanalytics.FilterActivity{
+				Filter: aws.String(tfMap[names.AttrFilter].(string)),
+				Name:   aws.String(tfMap[names.AttrName].(string)),
+				Next:   expandActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Lambda = &iotanalytics.LambdaActivity{
+				BatchSize:  aws.Int64(int64(tfMap["batch_size"].(int))),
+				LambdaName: aws.String(tfMap["lambda_name"].(string)),
+				Name:       aws.String(tfMap[names.AttrName].(string)),
+				Next:       expandActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["math"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Math = &iotanalytics.MathActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Math:      aws.String(tfMap["math"].(string)),
+				Name:      aws.String(tfMap[names.AttrName].(string)),
+				Next:      expandActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["remove_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.RemoveAttributes = &iotanalytics.RemoveAttributesActivity{
+				Attributes: flex.ExpandStringList(tfMap[names.AttrAttributes].([]interface{})),
+				Name:       aws.String(tfMap[names.AttrName].(string)),
+				Next:       expandActivityNext(tfMap),
+			}
+		}
+
+		if v, ok := tfMap["select_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.SelectAttributes = &iotanalytics.SelectAttributesActivity{
+				Attributes: flex.ExpandStringList(tfMap[names.AttrAttributes].([]interface{})),
+				Name:       aws.String(tfMap[names.AttrName].(string)),
+				Next:       expandActivityNext(tfMap),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandActivityNext(tfMap map[string]interface{}) *string {
+	if v, ok := tfMap["next"].(string); ok && v != "" {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func flattenPipelineActivities(apiObjects []*iotanalytics.PipelineActivity) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AddAttributes; v != nil {
+			tfMap["add_attributes"] = []interface{}{map[string]interface{}{
+				names.AttrAttributes: flex.FlattenStringMap(v.Attributes),
+				names.AttrName:       aws.StringValue(v.Name),
+				"next":               aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Channel; v != nil {
+			tfMap["channel"] = []interface{}{map[string]interface{}{
+				"channel_name": aws.StringValue(v.ChannelName),
+				names.AttrName: aws.StringValue(v.Name),
+				"next":         aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Datastore; v != nil {
+			tfMap["datastore"] = []interface{}{map[string]interface{}{
+				"datastore_name": aws.StringValue(v.DatastoreName),
+				names.AttrName:   aws.StringValue(v.Name),
+			}}
+		}
+
+		if v := apiObject.DeviceRegistryEnrich; v != nil {
+			tfMap["device_registry_enrich"] = []interface{}{map[string]interface{}{
+				"attribute":       aws.StringValue(v.Attribute),
+				names.AttrName:    aws.StringValue(v.Name),
+				"next":            aws.StringValue(v.Next),
+				names.AttrRoleARN: aws.StringValue(v.RoleArn),
+				"thing_name":      aws.StringValue(v.ThingName),
+			}}
+		}
+
+		if v := apiObject.DeviceShadowEnrich; v != nil {
+			tfMap["device_shadow_enrich"] = []interface{}{map[string]interface{}{
+				"attribute":       aws.StringValue(v.Attribute),
+				names.AttrName:    aws.StringValue(v.Name),
+				"next":            aws.StringValue(v.Next),
+				names.AttrRoleARN: aws.StringValue(v.RoleArn),
+				"thing_name":      aws.StringValue(v.ThingName),
+			}}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap[names.AttrFilter] = []interface{}{map[string]interface{}{
+				names.AttrFilter: aws.StringValue(v.Filter),
+				names.AttrName:   aws.StringValue(v.Name),
+				"next":           aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = []interface{}{map[string]interface{}{
+				"batch_size":   aws.Int64Value(v.BatchSize),
+				"lambda_name":  aws.StringValue(v.LambdaName),
+				names.AttrName: aws.StringValue(v.Name),
+				"next":         aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Math; v != nil {
+			tfMap["math"] = []interface{}{map[string]interface{}{
+				"attribute":    aws.StringValue(v.Attribute),
+				"math":         aws.StringValue(v.Math),
+				names.AttrName: aws.StringValue(v.Name),
+				"next":         aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.RemoveAttributes; v != nil {
+			tfMap["remove_attributes"] = []interface{}{map[string]interface{}{
+				names.AttrAttributes: flex.FlattenStringList(v.Attributes),
+				names.AttrName:       aws.StringValue(v.Name),
+				"next":               aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.SelectAttributes; v != nil {
+			tfMap["select_attributes"] = []interface{}{map[string]interface{}{
+				names.AttrAttributes: flex.FlattenStringList(v.Attributes),
+				names.AttrName:       aws.StringValue(v.Name),
+				"next":               aws.StringValue(v.Next),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotdeviceadvisor
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotdeviceadvisor"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotdeviceadvisor_suite_definition", name="Suite Definition")
+// @Tags(identifierAttribute="arn")
+func ResourceSuiteDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSuiteDefinitionCreate,
+		ReadWithoutTimeout:   resourceSuiteDefinitionRead,
+		UpdateWithoutTimeout: resourceSuiteDefinitionUpdate,
+		DeleteWithoutTimeout: resourceSuiteDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"suite_definition_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_permission_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"devices": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCertificateARN: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"device_role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"thing_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"intended_for_qualification": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"is_long_duration_test": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						names.AttrProtocol: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(iotdeviceadvisor.Protocol_Values(), false),
+						},
+						"root_group": {
+							Type:                  schema.TypeString,
+							Required:              true,
+							ValidateFunc:          validation.All(validation.StringLenBetween(0, 2048), validation.StringIsJSON),
+							DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+							DiffSuppressOnRefresh: true,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+						"suite_definition_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"suite_definition_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"suite_definition_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSuiteDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTDeviceAdvisorConn(ctx)
+
+	input := &iotdeviceadvisor.CreateSuiteDefinitionInput{
+		SuiteDefinitionConfiguration: expandSuiteDefinitionConfiguration(d.Get("suite_definition_configuration").([]interface{})[0].(map[string]interface{})),
+		Tags:                         getTagsIn(ctx),
+	}
+	name := aws.StringValue(input.SuiteDefinitionConfiguration.SuiteDefinitionName)
+
+	output, err := conn.CreateSuiteDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Device Advisor Suite Definition (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SuiteDefinitionId))
+
+	return append(diags, resourceSuiteDefinitionRead(ctx, d, meta)...)
+}
+
+func resourceSuiteDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTDeviceAdvisorConn(ctx)
+
+	output, err := FindSuiteDefinitionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Device Advisor Suite Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Device Advisor Suite Definition (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.SuiteDefinitionArn)
+	if err := d.Set("suite_definition_configuration", []interface{}{flattenSuiteDefinitionConfiguration(output.SuiteDefinitionConfiguration)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting suite_definition_configuration: %s", err)
+	}
+	d.Set("suite_definition_id", output.SuiteDefinitionId)
+	d.Set("suite_definition_version", output.SuiteDefinitionVersion)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceSuiteDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTDeviceAdvisorConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotdeviceadvisor.UpdateSuiteDefinitionInput{
+			SuiteDefinitionConfiguration: expandSuiteDefinitionConfiguration(d.Get("suite_definition_configuration").([]interface{})[0].(map[string]interface{})),
+			SuiteDefinitionId:            aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateSuiteDefinitionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Device Advisor Suite Definition (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSuiteDefinitionRead(ctx, d, meta)...)
+}
+
+func resourceSuiteDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTDeviceAdvisorConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Device Advisor Suite Definition: %s", d.Id())
+	_, err := conn.DeleteSuiteDefinitionWithContext(ctx, &iotdeviceadvisor.DeleteSuiteDefinitionInput{
+		SuiteDefinitionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotdeviceadvisor.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Device Advisor Suite Definition (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindSuiteDefinitionByID(ctx context.Context, conn *iotdeviceadvisor.IoTDeviceAdvisor, id string) (*iotdeviceadvisor.GetSuiteDefinitionOutput, error) {
+	input := &iotdeviceadvisor.GetSuiteDefinitionInput{
+		SuiteDefinitionId: aws.String(id),
+	}
+
+	output, err := conn.GetSuiteDefinitionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotdeviceadvisor.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SuiteDefinitionConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandSuiteDefinitionConfiguration(tfMap map[string]interface{}) *iotdeviceadvisor.SuiteDefinitionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotdeviceadvisor.SuiteDefinitionConfiguration{}
+
+	if v, ok := tfMap["device_permission_role_arn"].(string); ok && v != "" {
+		apiObject.DevicePermissionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["devices"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Devices = expandDevicesUnderTest(v)
+	}
+
+	if v, ok := tfMap["intended_for_qualification"].(bool); ok {
+		apiObject.IntendedForQualification = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["is_long_duration_test"].(bool); ok {
+		apiObject.IsLongDurationTest = aws.Bool(v)
+	}
+
+	if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["root_group"].(string); ok && v != "" {
+		apiObject.RootGroup = aws.String(v)
+	}
+
+	if v, ok := tfMap["suite_definition_name"].(string); ok && v != "" {
+		apiObject.SuiteDefinitionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDeviceUnderTest(tfMap map[string]interface{}) *iotdeviceadvisor.DeviceUnderTest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotdeviceadvisor.DeviceUnderTest{}
+
+	if v, ok := tfMap[names.AttrCertificateARN].(string); ok && v != "" {
+		apiObject.CertificateArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["device_role_arn"].(string); ok && v != "" {
+		apiObject.DeviceRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["thing_arn"].(string); ok && v != "" {
+		apiObject.ThingArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDevicesUnderTest(tfList []interface{}) []*iotdeviceadvisor.DeviceUnderTest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotdeviceadvisor.DeviceUnderTest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandDeviceUnderTest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func flattenSuiteDefinitionConfiguration(apiObject *iotdeviceadvisor.SuiteDefinitionConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"intended_for_qualification": aws.BoolValue(apiObject.IntendedForQualification),
+		"is_long_duration_test":      aws.BoolValue(apiObject.IsLongDurationTest),
+	}
+
+	if v := apiObject.DevicePermissionRoleArn; v != nil {
+		tfMap["device_permission_role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Devices; v != nil {
+		tfMap["devices"] = flattenDevicesUnderTest(v)
+	}
+
+	if v := apiObject.Protocol; v != nil {
+		tfMap[names.AttrProtocol] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RootGroup; v != nil {
+		tfMap["root_group"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SuiteDefinitionName; v != nil {
+		tfMap["suite_definition_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenDeviceUnderTest(apiObject *iotdeviceadvisor.DeviceUnderTest) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CertificateArn; v != nil {
+		tfMap[names.AttrCertificateARN] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DeviceRoleArn; v != nil {
+		tfMap["device_role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ThingArn; v != nil {
+		tfMap["thing_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenDevicesUnderTest(apiObjects []*iotdeviceadvisor.DeviceUnderTest) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenDeviceUnderTest(apiObject))
+	}
+
+	return tfList
+}
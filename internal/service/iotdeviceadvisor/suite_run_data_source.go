@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotdeviceadvisor
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotdeviceadvisor"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iotdeviceadvisor_suite_run", name="Suite Run")
+// @Tags(identifierAttribute="arn")
+func DataSourceSuiteRun() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSuiteRunRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStartTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"suite_definition_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"suite_definition_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"suite_run_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parallel_run": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"primary_device": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCertificateARN: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"device_role_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"thing_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"selected_test_list": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"suite_run_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"test_result": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"group_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"test": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end_time": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"failure": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"log_url": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrStartTime: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrStatus: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"test_case_definition_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"test_case_definition_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"test_case_run_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"warnings": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSuiteRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTDeviceAdvisorConn(ctx)
+
+	suiteDefinitionID := d.Get("suite_definition_id").(string)
+	suiteRunID := d.Get("suite_run_id").(string)
+	output, err := FindSuiteRunByTwoPartKey(ctx, conn, suiteDefinitionID, suiteRunID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Device Advisor Suite Run (%s): %s", suiteRunID, err)
+	}
+
+	d.SetId(aws.StringValue(output.SuiteRunId))
+	d.Set(names.AttrARN, output.SuiteRunArn)
+	if output.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(output.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	d.Set("error_reason", output.ErrorReason)
+	if output.StartTime != nil {
+		d.Set(names.AttrStartTime, aws.TimeValue(output.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrStartTime, nil)
+	}
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("suite_definition_id", output.SuiteDefinitionId)
+	d.Set("suite_definition_version", output.SuiteDefinitionVersion)
+	if output.SuiteRunConfiguration != nil {
+		if err := d.Set("suite_run_configuration", []interface{}{flattenSuiteRunConfiguration(output.SuiteRunConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting suite_run_configuration: %s", err)
+		}
+	} else {
+		d.Set("suite_run_configuration", nil)
+	}
+	d.Set("suite_run_id", output.SuiteRunId)
+	if output.TestResult != nil {
+		if err := d.Set("test_result", []interface{}{flattenTestResult(output.TestResult)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting test_result: %s", err)
+		}
+	} else {
+		d.Set("test_result", nil)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func FindSuiteRunByTwoPartKey(ctx context.Context, conn *iotdeviceadvisor.IoTDeviceAdvisor, suiteDefinitionID, suiteRunID string) (*iotdeviceadvisor.GetSuiteRunOutput, error) {
+	input := &iotdeviceadvisor.GetSuiteRunInput{
+		SuiteDefinitionId: aws.String(suiteDefinitionID),
+		SuiteRunId:        aws.String(suiteRunID),
+	}
+
+	output, err := conn.GetSuiteRunWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotdeviceadvisor.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenSuiteRunConfiguration(apiObject *iotdeviceadvisor.SuiteRunConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"parallel_run": aws.BoolValue(apiObject.ParallelRun),
+	}
+
+	if v := apiObject.PrimaryDevice; v != nil {
+		tfMap["primary_device"] = []interface{}{flattenDeviceUnderTest(v)}
+	}
+
+	if v := apiObject.SelectedTestList; v != nil {
+		tfMap["selected_test_list"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenTestResult(apiObject *iotdeviceadvisor.TestResult) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Groups; v != nil {
+		tfMap["group"] = flattenGroupResults(v)
+	}
+
+	return tfMap
+}
+
+func flattenGroupResults(apiObjects []*iotdeviceadvisor.GroupResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.GroupId; v != nil {
+			tfMap["group_id"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.GroupName; v != nil {
+			tfMap["group_name"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Tests; v != nil {
+			tfMap["test"] = flattenTestCaseRuns(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTestCaseRuns(apiObjects []*iotdeviceadvisor.TestCaseRun) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.EndTime; v != nil {
+			tfMap["end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.Failure; v != nil {
+			tfMap["failure"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.LogUrl; v != nil {
+			tfMap["log_url"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.StartTime; v != nil {
+			tfMap[names.AttrStartTime] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.Status; v != nil {
+			tfMap[names.AttrStatus] = aws.StringValue(v)
+		}
+
+		if v := apiObject.TestCaseDefinitionId; v != nil {
+			tfMap["test_case_definition_id"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.TestCaseDefinitionName; v != nil {
+			tfMap["test_case_definition_name"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.TestCaseRunId; v != nil {
+			tfMap["test_case_run_id"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Warnings; v != nil {
+			tfMap["warnings"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotdeviceadvisor_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Suite runs can only be started outside of Terraform, so the data source is
+// exercised against a suite definition that has never been run.
+func TestAccIoTDeviceAdvisorSuiteRunDataSource_notFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTDeviceAdvisorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSuiteDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSuiteRunDataSourceConfig_notFound(rName),
+				ExpectError: regexache.MustCompile(`reading IoT Device Advisor Suite Run`),
+			},
+		},
+	})
+}
+
+func testAccSuiteRunDataSourceConfig_notFound(rName string) string {
+	return acctest.ConfigCompose(testAccSuiteDefinitionConfig_basic(rName), `
+data "aws_iotdeviceadvisor_suite_run" "test" {
+  suite_definition_id = aws_iotdeviceadvisor_suite_definition.test.suite_definition_id
+  suite_run_id        = "abcdef123456"
+}
+`)
+}
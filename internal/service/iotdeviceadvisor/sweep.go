@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotdeviceadvisor
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotdeviceadvisor"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_iotdeviceadvisor_suite_definition", &resource.Sweeper{
+		Name: "aws_iotdeviceadvisor_suite_definition",
+		F:    sweepSuiteDefinitions,
+	})
+}
+
+func sweepSuiteDefinitions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTDeviceAdvisorConn(ctx)
+	input := &iotdeviceadvisor.ListSuiteDefinitionsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListSuiteDefinitionsPagesWithContext(ctx, input, func(page *iotdeviceadvisor.ListSuiteDefinitionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SuiteDefinitionInformationList {
+			r := ResourceSuiteDefinition()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SuiteDefinitionId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Device Advisor Suite Definition sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Device Advisor Suite Definitions (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Device Advisor Suite Definitions (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_iotevents_alarm_model", &resource.Sweeper{
+		Name: "aws_iotevents_alarm_model",
+		F:    sweepAlarmModels,
+	})
+
+	resource.AddTestSweepers("aws_iotevents_detector_model", &resource.Sweeper{
+		Name: "aws_iotevents_detector_model",
+		F:    sweepDetectorModels,
+	})
+
+	resource.AddTestSweepers("aws_iotevents_input", &resource.Sweeper{
+		Name: "aws_iotevents_input",
+		F:    sweepInputs,
+		Dependencies: []string{
+			"aws_iotevents_alarm_model",
+			"aws_iotevents_detector_model",
+		},
+	})
+}
+
+func sweepAlarmModels(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTEventsConn(ctx)
+	input := &iotevents.ListAlarmModelsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.ListAlarmModelsWithContext(ctx, input)
+
+		if awsv1.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Alarm Model sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IoT Events Alarm Models (%s): %w", region, err)
+		}
+
+		for _, v := range output.AlarmModelSummaries {
+			r := ResourceAlarmModel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.AlarmModelName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Events Alarm Models (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDetectorModels(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTEventsConn(ctx)
+	input := &iotevents.ListDetectorModelsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.ListDetectorModelsWithContext(ctx, input)
+
+		if awsv1.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Detector Model sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IoT Events Detector Models (%s): %w", region, err)
+		}
+
+		for _, v := range output.DetectorModelSummaries {
+			r := ResourceDetectorModel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DetectorModelName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Events Detector Models (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepInputs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTEventsConn(ctx)
+	input := &iotevents.ListInputsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.ListInputsWithContext(ctx, input)
+
+		if awsv1.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Input sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IoT Events Inputs (%s): %w", region, err)
+		}
+
+		for _, v := range output.InputSummaries {
+			r := ResourceInput()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.InputName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Events Inputs (%s): %w", region, err)
+	}
+
+	return nil
+}
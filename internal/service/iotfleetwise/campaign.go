@@ -0,0 +1,853 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotfleetwise_campaign", name="Campaign")
+// @Tags(identifierAttribute="arn")
+func ResourceCampaign() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCampaignCreate,
+		ReadWithoutTimeout:   resourceCampaignRead,
+		UpdateWithoutTimeout: resourceCampaignUpdate,
+		DeleteWithoutTimeout: resourceCampaignDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collection_scheme": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition_based_collection_scheme": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"collection_scheme.0.condition_based_collection_scheme", "collection_scheme.0.time_based_collection_scheme"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition_language_version": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrExpression: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2048),
+									},
+									"minimum_trigger_interval_ms": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"trigger_mode": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(iotfleetwise.TriggerMode_Values(), false),
+									},
+								},
+							},
+						},
+						"time_based_collection_scheme": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"collection_scheme.0.condition_based_collection_scheme", "collection_scheme.0.time_based_collection_scheme"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"period_ms": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(10000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"compression": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(iotfleetwise.Compression_Values(), false),
+			},
+			"data_destination_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"data_format": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(iotfleetwise.DataFormat_Values(), false),
+									},
+									names.AttrPrefix: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"storage_compression_format": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(iotfleetwise.StorageCompressionFormat_Values(), false),
+									},
+								},
+							},
+						},
+						"timestream_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrExecutionRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"timestream_table_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"data_extra_dimensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"diagnostics_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(iotfleetwise.DiagnosticsMode_Values(), false),
+			},
+			"expiry_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validNameFunc(),
+			},
+			"post_trigger_collection_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrPriority: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"signal_catalog_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"signals_to_collect": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_sample_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"minimum_sampling_interval_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"spooling_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(iotfleetwise.SpoolingMode_Values(), false),
+			},
+			names.AttrStartTime: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					iotfleetwise.CampaignStatusRunning,
+					iotfleetwise.CampaignStatusSuspended,
+					iotfleetwise.CampaignStatusWaitingForApproval,
+				}, false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrTargetARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCampaignCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotfleetwise.CreateCampaignInput{
+		CollectionScheme: expandCollectionScheme(d.Get("collection_scheme").([]interface{})[0].(map[string]interface{})),
+		Name:             aws.String(name),
+		SignalCatalogArn: aws.String(d.Get("signal_catalog_arn").(string)),
+		Tags:             getTagsIn(ctx),
+		TargetArn:        aws.String(d.Get(names.AttrTargetARN).(string)),
+	}
+
+	if v, ok := d.GetOk("compression"); ok {
+		input.Compression = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data_destination_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataDestinationConfigs = expandDataDestinationConfigs(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("data_extra_dimensions"); ok && len(v.([]interface{})) > 0 {
+		input.DataExtraDimensions = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("diagnostics_mode"); ok {
+		input.DiagnosticsMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expiry_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExpiryTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("post_trigger_collection_duration"); ok {
+		input.PostTriggerCollectionDuration = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk(names.AttrPriority); ok {
+		input.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("signals_to_collect"); ok && len(v.([]interface{})) > 0 {
+		input.SignalsToCollect = expandSignalInformations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("spooling_mode"); ok {
+		input.SpoolingMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrStartTime); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.StartTime = aws.Time(v)
+	}
+
+	_, err := conn.CreateCampaignWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT FleetWise Campaign (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	output, err := waitCampaignCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT FleetWise Campaign (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		if err := updateCampaignStatus(ctx, conn, d.Id(), aws.StringValue(output.Status), v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceCampaignRead(ctx, d, meta)...)
+}
+
+func resourceCampaignRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	output, err := FindCampaignByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT FleetWise Campaign (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Campaign (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	if output.CollectionScheme != nil {
+		if err := d.Set("collection_scheme", []interface{}{flattenCollectionScheme(output.CollectionScheme)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting collection_scheme: %s", err)
+		}
+	} else {
+		d.Set("collection_scheme", nil)
+	}
+	d.Set("compression", output.Compression)
+	if err := d.Set("data_destination_config", flattenDataDestinationConfigs(output.DataDestinationConfigs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_destination_config: %s", err)
+	}
+	d.Set("data_extra_dimensions", aws.StringValueSlice(output.DataExtraDimensions))
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("diagnostics_mode", output.DiagnosticsMode)
+	if output.ExpiryTime != nil {
+		d.Set("expiry_time", aws.TimeValue(output.ExpiryTime).Format(time.RFC3339))
+	} else {
+		d.Set("expiry_time", nil)
+	}
+	d.Set(names.AttrName, output.Name)
+	d.Set("post_trigger_collection_duration", output.PostTriggerCollectionDuration)
+	d.Set(names.AttrPriority, output.Priority)
+	d.Set("signal_catalog_arn", output.SignalCatalogArn)
+	if err := d.Set("signals_to_collect", flattenSignalInformations(output.SignalsToCollect)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting signals_to_collect: %s", err)
+	}
+	d.Set("spooling_mode", output.SpoolingMode)
+	if output.StartTime != nil {
+		d.Set(names.AttrStartTime, aws.TimeValue(output.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrStartTime, nil)
+	}
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrTargetARN, output.TargetArn)
+
+	return diags
+}
+
+func resourceCampaignUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	if d.HasChanges("data_extra_dimensions", names.AttrDescription) {
+		input := &iotfleetwise.UpdateCampaignInput{
+			Action: aws.String(iotfleetwise.UpdateCampaignActionUpdate),
+			Name:   aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_extra_dimensions") {
+			input.DataExtraDimensions = flex.ExpandStringList(d.Get("data_extra_dimensions").([]interface{}))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		_, err := conn.UpdateCampaignWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Campaign (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrStatus) {
+		o, n := d.GetChange(names.AttrStatus)
+
+		if err := updateCampaignStatus(ctx, conn, d.Id(), o.(string), n.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceCampaignRead(ctx, d, meta)...)
+}
+
+func resourceCampaignDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT FleetWise Campaign: %s", d.Id())
+	_, err := conn.DeleteCampaignWithContext(ctx, &iotfleetwise.DeleteCampaignInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotfleetwise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT FleetWise Campaign (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updateCampaignStatus moves a campaign from one status to another.
+// Campaigns are approved once and can then be suspended and resumed; a campaign cannot return to WAITING_FOR_APPROVAL.
+func updateCampaignStatus(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name, from, to string, timeout time.Duration) error {
+	for from != to {
+		var action string
+
+		switch {
+		case from == iotfleetwise.CampaignStatusWaitingForApproval:
+			action = iotfleetwise.UpdateCampaignActionApprove
+		case from == iotfleetwise.CampaignStatusRunning && to == iotfleetwise.CampaignStatusSuspended:
+			action = iotfleetwise.UpdateCampaignActionSuspend
+		case from == iotfleetwise.CampaignStatusSuspended && to == iotfleetwise.CampaignStatusRunning:
+			action = iotfleetwise.UpdateCampaignActionResume
+		default:
+			return fmt.Errorf("IoT FleetWise Campaign (%s) cannot transition from %s to %s", name, from, to)
+		}
+
+		_, err := conn.UpdateCampaignWithContext(ctx, &iotfleetwise.UpdateCampaignInput{
+			Action: aws.String(action),
+			Name:   aws.String(name),
+		})
+
+		if err != nil {
+			return fmt.Errorf("updating IoT FleetWise Campaign (%s) action %s: %w", name, action, err)
+		}
+
+		output, err := waitCampaignStatusUpdated(ctx, conn, name, from, timeout)
+
+		if err != nil {
+			return fmt.Errorf("waiting for IoT FleetWise Campaign (%s) action %s: %w", name, action, err)
+		}
+
+		from = aws.StringValue(output.Status)
+	}
+
+	return nil
+}
+
+func FindCampaignByName(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) (*iotfleetwise.GetCampaignOutput, error) {
+	input := &iotfleetwise.GetCampaignInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCampaignWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotfleetwise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusCampaign(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCampaignByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitCampaignCreated(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string, timeout time.Duration) (*iotfleetwise.GetCampaignOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotfleetwise.CampaignStatusCreating},
+		Target:  []string{iotfleetwise.CampaignStatusWaitingForApproval, iotfleetwise.CampaignStatusRunning},
+		Refresh: statusCampaign(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotfleetwise.GetCampaignOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCampaignStatusUpdated(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name, from string, timeout time.Duration) (*iotfleetwise.GetCampaignOutput, error) {
+	var target []string
+	for _, v := range iotfleetwise.CampaignStatus_Values() {
+		if v != from && v != iotfleetwise.CampaignStatusCreating {
+			target = append(target, v)
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{from},
+		Target:  target,
+		Refresh: statusCampaign(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotfleetwise.GetCampaignOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCollectionScheme(tfMap map[string]interface{}) *iotfleetwise.CollectionScheme {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotfleetwise.CollectionScheme{}
+
+	if v, ok := tfMap["condition_based_collection_scheme"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ConditionBasedCollectionScheme = expandConditionBasedCollectionScheme(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["time_based_collection_scheme"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TimeBasedCollectionScheme = expandTimeBasedCollectionScheme(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandConditionBasedCollectionScheme(tfMap map[string]interface{}) *iotfleetwise.ConditionBasedCollectionScheme {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotfleetwise.ConditionBasedCollectionScheme{}
+
+	if v, ok := tfMap["condition_language_version"].(int); ok && v != 0 {
+		apiObject.ConditionLanguageVersion = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap[names.AttrExpression].(string); ok && v != "" {
+		apiObject.Expression = aws.String(v)
+	}
+
+	if v, ok := tfMap["minimum_trigger_interval_ms"].(int); ok && v != 0 {
+		apiObject.MinimumTriggerIntervalMs = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["trigger_mode"].(string); ok && v != "" {
+		apiObject.TriggerMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTimeBasedCollectionScheme(tfMap map[string]interface{}) *iotfleetwise.TimeBasedCollectionScheme {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotfleetwise.TimeBasedCollectionScheme{}
+
+	if v, ok := tfMap["period_ms"].(int); ok && v != 0 {
+		apiObject.PeriodMs = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandDataDestinationConfigs(tfList []interface{}) []*iotfleetwise.DataDestinationConfig {
+	var apiObjects []*iotfleetwise.DataDestinationConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotfleetwise.DataDestinationConfig{}
+
+		if v, ok := tfMap["s3_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.S3Config = expandS3Config(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["timestream_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.TimestreamConfig = expandTimestreamConfig(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3Config(tfMap map[string]interface{}) *iotfleetwise.S3Config {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotfleetwise.S3Config{}
+
+	if v, ok := tfMap["bucket_arn"].(string); ok && v != "" {
+		apiObject.BucketArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["data_format"].(string); ok && v != "" {
+		apiObject.DataFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrPrefix].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage_compression_format"].(string); ok && v != "" {
+		apiObject.StorageCompressionFormat = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTimestreamConfig(tfMap map[string]interface{}) *iotfleetwise.TimestreamConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotfleetwise.TimestreamConfig{}
+
+	if v, ok := tfMap[names.AttrExecutionRoleARN].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestream_table_arn"].(string); ok && v != "" {
+		apiObject.TimestreamTableArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSignalInformations(tfList []interface{}) []*iotfleetwise.SignalInformation {
+	var apiObjects []*iotfleetwise.SignalInformation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotfleetwise.SignalInformation{}
+
+		if v, ok := tfMap["max_sample_count"].(int); ok && v != 0 {
+			apiObject.MaxSampleCount = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["minimum_sampling_interval_ms"].(int); ok && v != 0 {
+			apiObject.MinimumSamplingIntervalMs = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCollectionScheme(apiObject *iotfleetwise.CollectionScheme) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConditionBasedCollectionScheme; v != nil {
+		tfMap["condition_based_collection_scheme"] = []interface{}{map[string]interface{}{
+			"condition_language_version":  aws.Int64Value(v.ConditionLanguageVersion),
+			names.AttrExpression:          aws.StringValue(v.Expression),
+			"minimum_trigger_interval_ms": aws.Int64Value(v.MinimumTriggerIntervalMs),
+			"trigger_mode":                aws.StringValue(v.TriggerMode),
+		}}
+	}
+
+	if v := apiObject.TimeBasedCollectionScheme; v != nil {
+		tfMap["time_based_collection_scheme"] = []interface{}{map[string]interface{}{
+			"period_ms": aws.Int64Value(v.PeriodMs),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenDataDestinationConfigs(apiObjects []*iotfleetwise.DataDestinationConfig) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.S3Config; v != nil {
+			tfMap["s3_config"] = []interface{}{map[string]interface{}{
+				"bucket_arn":                 aws.StringValue(v.BucketArn),
+				"data_format":                aws.StringValue(v.DataFormat),
+				names.AttrPrefix:             aws.StringValue(v.Prefix),
+				"storage_compression_format": aws.StringValue(v.StorageCompressionFormat),
+			}}
+		}
+
+		if v := apiObject.TimestreamConfig; v != nil {
+			tfMap["timestream_config"] = []interface{}{map[string]interface{}{
+				names.AttrExecutionRoleARN: aws.StringValue(v.ExecutionRoleArn),
+				"timestream_table_arn":     aws.StringValue(v.TimestreamTableArn),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSignalInformations(apiObjects []*iotfleetwise.SignalInformation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"max_sample_count":             aws.Int64Value(apiObject.MaxSampleCount),
+			"minimum_sampling_interval_ms": aws.Int64Value(apiObject.MinimumSamplingIntervalMs),
+			names.AttrName:                 aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotfleetwise "github.com/hashicorp/terraform-provider-aws/internal/service/iotfleetwise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCampaign_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetCampaignOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_campaign.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName, "WAITING_FOR_APPROVAL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotfleetwise", "campaign/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "collection_scheme.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "collection_scheme.0.time_based_collection_scheme.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "collection_scheme.0.time_based_collection_scheme.0.period_ms", "10000"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "signal_catalog_arn", "aws_iotfleetwise_signal_catalog.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "signals_to_collect.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "signals_to_collect.0.name", "Vehicle.Speed"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "WAITING_FOR_APPROVAL"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, "aws_iotfleetwise_vehicle.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCampaign_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetCampaignOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_campaign.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName, "WAITING_FOR_APPROVAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotfleetwise.ResourceCampaign(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCampaign_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetCampaignOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_campaign.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName, "WAITING_FOR_APPROVAL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "WAITING_FOR_APPROVAL"),
+				),
+			},
+			{
+				Config: testAccCampaignConfig_basic(rName, "RUNNING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "RUNNING"),
+				),
+			},
+			{
+				Config: testAccCampaignConfig_basic(rName, "SUSPENDED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "SUSPENDED"),
+				),
+			},
+			{
+				Config: testAccCampaignConfig_basic(rName, "RUNNING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "RUNNING"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCampaignExists(ctx context.Context, n string, v *iotfleetwise.GetCampaignOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+		output, err := tfiotfleetwise.FindCampaignByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCampaignDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotfleetwise_campaign" {
+				continue
+			}
+
+			_, err := tfiotfleetwise.FindCampaignByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT FleetWise Campaign %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCampaignConfig_basic(rName, status string) string {
+	return acctest.ConfigCompose(testAccVehicleConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iotfleetwise_campaign" "test" {
+  name               = %[1]q
+  signal_catalog_arn = aws_iotfleetwise_signal_catalog.test.arn
+  target_arn         = aws_iotfleetwise_vehicle.test.arn
+  status             = %[2]q
+
+  collection_scheme {
+    time_based_collection_scheme {
+      period_ms = 10000
+    }
+  }
+
+  signals_to_collect {
+    name = "Vehicle.Speed"
+  }
+}
+`, rName, status))
+}
@@ -0,0 +1,417 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotfleetwise_decoder_manifest", name="Decoder Manifest")
+// @Tags(identifierAttribute="arn")
+func ResourceDecoderManifest() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDecoderManifestCreate,
+		ReadWithoutTimeout:   resourceDecoderManifestRead,
+		UpdateWithoutTimeout: resourceDecoderManifestUpdate,
+		DeleteWithoutTimeout: resourceDecoderManifestDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"model_manifest_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validNameFunc(),
+			},
+			"network_interfaces": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validJSONList[iotfleetwise.NetworkInterface](),
+				DiffSuppressFunc: suppressEquivalentJSONListDiffs(networkInterfaceID, normalizeNetworkInterface),
+				StateFunc: func(v interface{}) string {
+					json, _ := jsonListCanonicalJSON(v.(string), networkInterfaceID, normalizeNetworkInterface)
+					return json
+				},
+			},
+			"signal_decoders": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validJSONList[iotfleetwise.SignalDecoder](),
+				DiffSuppressFunc: suppressEquivalentJSONListDiffs(signalDecoderFullyQualifiedName, nil),
+				StateFunc: func(v interface{}) string {
+					json, _ := jsonListCanonicalJSON(v.(string), signalDecoderFullyQualifiedName, nil)
+					return json
+				},
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{iotfleetwise.ManifestStatusActive, iotfleetwise.ManifestStatusDraft}, false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDecoderManifestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotfleetwise.CreateDecoderManifestInput{
+		ModelManifestArn: aws.String(d.Get("model_manifest_arn").(string)),
+		Name:             aws.String(name),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_interfaces"); ok {
+		networkInterfaces, err := expandJSONList[iotfleetwise.NetworkInterface](v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT FleetWise Decoder Manifest (%s): network_interfaces: %s", name, err)
+		}
+
+		input.NetworkInterfaces = networkInterfaces
+	}
+
+	if v, ok := d.GetOk("signal_decoders"); ok {
+		signalDecoders, err := expandJSONList[iotfleetwise.SignalDecoder](v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT FleetWise Decoder Manifest (%s): signal_decoders: %s", name, err)
+		}
+
+		input.SignalDecoders = signalDecoders
+	}
+
+	_, err := conn.CreateDecoderManifestWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT FleetWise Decoder Manifest (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	// Decoder manifests are always created as drafts.
+	if v, ok := d.GetOk(names.AttrStatus); ok && v.(string) == iotfleetwise.ManifestStatusActive {
+		_, err := conn.UpdateDecoderManifestWithContext(ctx, &iotfleetwise.UpdateDecoderManifestInput{
+			Name:   aws.String(d.Id()),
+			Status: aws.String(iotfleetwise.ManifestStatusActive),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "activating IoT FleetWise Decoder Manifest (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDecoderManifestValidated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT FleetWise Decoder Manifest (%s) activate: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDecoderManifestRead(ctx, d, meta)...)
+}
+
+func resourceDecoderManifestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	output, err := FindDecoderManifestByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT FleetWise Decoder Manifest (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Decoder Manifest (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("model_manifest_arn", output.ModelManifestArn)
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrStatus, output.Status)
+
+	networkInterfaces, err := findDecoderManifestNetworkInterfacesByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Decoder Manifest (%s) network interfaces: %s", d.Id(), err)
+	}
+
+	v, err := flattenJSONList(networkInterfaces, networkInterfaceID, normalizeNetworkInterface)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Decoder Manifest (%s): %s", d.Id(), err)
+	}
+	d.Set("network_interfaces", v)
+
+	signalDecoders, err := findDecoderManifestSignalDecodersByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Decoder Manifest (%s) signal decoders: %s", d.Id(), err)
+	}
+
+	v, err = flattenJSONList(signalDecoders, signalDecoderFullyQualifiedName, nil)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Decoder Manifest (%s): %s", d.Id(), err)
+	}
+	d.Set("signal_decoders", v)
+
+	return diags
+}
+
+func resourceDecoderManifestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotfleetwise.UpdateDecoderManifestInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("network_interfaces") {
+			o, n := d.GetChange("network_interfaces")
+			oldNetworkInterfaces, err := expandJSONList[iotfleetwise.NetworkInterface](o.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Decoder Manifest (%s): network_interfaces: %s", d.Id(), err)
+			}
+			newNetworkInterfaces, err := expandJSONList[iotfleetwise.NetworkInterface](n.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Decoder Manifest (%s): network_interfaces: %s", d.Id(), err)
+			}
+
+			input.NetworkInterfacesToAdd, input.NetworkInterfacesToUpdate, input.NetworkInterfacesToRemove = diffJSONLists(oldNetworkInterfaces, newNetworkInterfaces, networkInterfaceID)
+		}
+
+		if d.HasChange("signal_decoders") {
+			o, n := d.GetChange("signal_decoders")
+			oldSignalDecoders, err := expandJSONList[iotfleetwise.SignalDecoder](o.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Decoder Manifest (%s): signal_decoders: %s", d.Id(), err)
+			}
+			newSignalDecoders, err := expandJSONList[iotfleetwise.SignalDecoder](n.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Decoder Manifest (%s): signal_decoders: %s", d.Id(), err)
+			}
+
+			input.SignalDecodersToAdd, input.SignalDecodersToUpdate, input.SignalDecodersToRemove = diffJSONLists(oldSignalDecoders, newSignalDecoders, signalDecoderFullyQualifiedName)
+		}
+
+		if d.HasChange(names.AttrStatus) {
+			input.Status = aws.String(d.Get(names.AttrStatus).(string))
+		}
+
+		_, err := conn.UpdateDecoderManifestWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Decoder Manifest (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDecoderManifestValidated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT FleetWise Decoder Manifest (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDecoderManifestRead(ctx, d, meta)...)
+}
+
+func resourceDecoderManifestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT FleetWise Decoder Manifest: %s", d.Id())
+	_, err := conn.DeleteDecoderManifestWithContext(ctx, &iotfleetwise.DeleteDecoderManifestInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotfleetwise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT FleetWise Decoder Manifest (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDecoderManifestByName(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) (*iotfleetwise.GetDecoderManifestOutput, error) {
+	input := &iotfleetwise.GetDecoderManifestInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDecoderManifestWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotfleetwise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findDecoderManifestNetworkInterfacesByName(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) ([]*iotfleetwise.NetworkInterface, error) {
+	input := &iotfleetwise.ListDecoderManifestNetworkInterfacesInput{
+		Name: aws.String(name),
+	}
+	var output []*iotfleetwise.NetworkInterface
+
+	err := conn.ListDecoderManifestNetworkInterfacesPagesWithContext(ctx, input, func(page *iotfleetwise.ListDecoderManifestNetworkInterfacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.NetworkInterfaces...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findDecoderManifestSignalDecodersByName(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) ([]*iotfleetwise.SignalDecoder, error) {
+	input := &iotfleetwise.ListDecoderManifestSignalsInput{
+		Name: aws.String(name),
+	}
+	var output []*iotfleetwise.SignalDecoder
+
+	err := conn.ListDecoderManifestSignalsPagesWithContext(ctx, input, func(page *iotfleetwise.ListDecoderManifestSignalsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.SignalDecoders...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusDecoderManifest(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDecoderManifestByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitDecoderManifestValidated(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string, timeout time.Duration) (*iotfleetwise.GetDecoderManifestOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotfleetwise.ManifestStatusValidating},
+		Target:  []string{iotfleetwise.ManifestStatusActive, iotfleetwise.ManifestStatusDraft},
+		Refresh: statusDecoderManifest(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotfleetwise.GetDecoderManifestOutput); ok {
+		if aws.StringValue(output.Status) == iotfleetwise.ManifestStatusInvalid {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func networkInterfaceID(apiObject *iotfleetwise.NetworkInterface) string {
+	return aws.StringValue(apiObject.InterfaceId)
+}
+
+// normalizeNetworkInterface treats unset and false OBD flags as equivalent,
+// as the API always returns them.
+func normalizeNetworkInterface(apiObject *iotfleetwise.NetworkInterface) {
+	if v := apiObject.ObdInterface; v != nil {
+		if !aws.BoolValue(v.HasTransmissionEcu) {
+			v.HasTransmissionEcu = nil
+		}
+
+		if !aws.BoolValue(v.UseExtendedIds) {
+			v.UseExtendedIds = nil
+		}
+	}
+}
+
+func signalDecoderFullyQualifiedName(apiObject *iotfleetwise.SignalDecoder) string {
+	return aws.StringValue(apiObject.FullyQualifiedName)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotfleetwise "github.com/hashicorp/terraform-provider-aws/internal/service/iotfleetwise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDecoderManifest_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetDecoderManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_decoder_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDecoderManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDecoderManifestConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDecoderManifestExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotfleetwise", "decoder-manifest/"+rName),
+					resource.TestCheckResourceAttrPair(resourceName, "model_manifest_arn", "aws_iotfleetwise_model_manifest.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "network_interfaces"),
+					resource.TestCheckResourceAttrSet(resourceName, "signal_decoders"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDecoderManifest_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetDecoderManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_decoder_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDecoderManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDecoderManifestConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDecoderManifestExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotfleetwise.ResourceDecoderManifest(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDecoderManifestExists(ctx context.Context, n string, v *iotfleetwise.GetDecoderManifestOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+		output, err := tfiotfleetwise.FindDecoderManifestByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDecoderManifestDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotfleetwise_decoder_manifest" {
+				continue
+			}
+
+			_, err := tfiotfleetwise.FindDecoderManifestByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT FleetWise Decoder Manifest %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDecoderManifestConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccModelManifestConfig_basic(rName, "ACTIVE"), fmt.Sprintf(`
+resource "aws_iotfleetwise_decoder_manifest" "test" {
+  name               = %[1]q
+  model_manifest_arn = aws_iotfleetwise_model_manifest.test.arn
+  status             = "ACTIVE"
+
+  network_interfaces = jsonencode([{
+    interfaceId = "1"
+    type        = "CAN_INTERFACE"
+
+    canInterface = {
+      name = "can0"
+    }
+  }])
+
+  signal_decoders = jsonencode([{
+    fullyQualifiedName = "Vehicle.Speed"
+    type               = "CAN_SIGNAL"
+    interfaceId        = "1"
+
+    canSignal = {
+      messageId   = 100
+      isBigEndian = false
+      isSigned    = false
+      startBit    = 0
+      offset      = 0
+      factor      = 1
+      length      = 8
+    }
+  }])
+}
+`, rName))
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Only one signal catalog can exist per account and Region, so all tests run serially.
+func TestAccIoTFleetWise_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"SignalCatalog": {
+			acctest.CtBasic:      testAccSignalCatalog_basic,
+			acctest.CtDisappears: testAccSignalCatalog_disappears,
+			"tags":               testAccSignalCatalog_tags,
+			"nodes":              testAccSignalCatalog_nodes,
+		},
+		"ModelManifest": {
+			acctest.CtBasic:      testAccModelManifest_basic,
+			acctest.CtDisappears: testAccModelManifest_disappears,
+			"status":             testAccModelManifest_status,
+		},
+		"DecoderManifest": {
+			acctest.CtBasic:      testAccDecoderManifest_basic,
+			acctest.CtDisappears: testAccDecoderManifest_disappears,
+		},
+		"Vehicle": {
+			acctest.CtBasic:      testAccVehicle_basic,
+			acctest.CtDisappears: testAccVehicle_disappears,
+			"attributes":         testAccVehicle_attributes,
+		},
+		"Fleet": {
+			acctest.CtBasic:      testAccFleet_basic,
+			acctest.CtDisappears: testAccFleet_disappears,
+		},
+		"Campaign": {
+			acctest.CtBasic:      testAccCampaign_basic,
+			acctest.CtDisappears: testAccCampaign_disappears,
+			"status":             testAccCampaign_status,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotfleetwise_model_manifest", name="Model Manifest")
+// @Tags(identifierAttribute="arn")
+func ResourceModelManifest() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceModelManifestCreate,
+		ReadWithoutTimeout:   resourceModelManifestRead,
+		UpdateWithoutTimeout: resourceModelManifestUpdate,
+		DeleteWithoutTimeout: resourceModelManifestDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validNameFunc(),
+			},
+			"nodes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"signal_catalog_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{iotfleetwise.ManifestStatusActive, iotfleetwise.ManifestStatusDraft}, false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceModelManifestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotfleetwise.CreateModelManifestInput{
+		Name:             aws.String(name),
+		Nodes:            flex.ExpandStringSet(d.Get("nodes").(*schema.Set)),
+		SignalCatalogArn: aws.String(d.Get("signal_catalog_arn").(string)),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateModelManifestWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT FleetWise Model Manifest (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	// Model manifests are always created as drafts.
+	if v, ok := d.GetOk(names.AttrStatus); ok && v.(string) == iotfleetwise.ManifestStatusActive {
+		_, err := conn.UpdateModelManifestWithContext(ctx, &iotfleetwise.UpdateModelManifestInput{
+			Name:   aws.String(d.Id()),
+			Status: aws.String(iotfleetwise.ManifestStatusActive),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "activating IoT FleetWise Model Manifest (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitModelManifestValidated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT FleetWise Model Manifest (%s) activate: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceModelManifestRead(ctx, d, meta)...)
+}
+
+func resourceModelManifestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	output, err := FindModelManifestByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT FleetWise Model Manifest (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Model Manifest (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrName, output.Name)
+	d.Set("signal_catalog_arn", output.SignalCatalogArn)
+	d.Set(names.AttrStatus, output.Status)
+
+	nodes, err := findModelManifestNodesByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Model Manifest (%s) nodes: %s", d.Id(), err)
+	}
+
+	var fullyQualifiedNames []string
+	for _, v := range nodes {
+		fullyQualifiedNames = append(fullyQualifiedNames, nodeFullyQualifiedName(v))
+	}
+	d.Set("nodes", fullyQualifiedNames)
+
+	return diags
+}
+
+func resourceModelManifestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotfleetwise.UpdateModelManifestInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("nodes") {
+			o, n := d.GetChange("nodes")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.NodesToAdd = flex.ExpandStringSet(add)
+			}
+
+			if remove := os.Difference(ns); remove.Len() > 0 {
+				input.NodesToRemove = flex.ExpandStringSet(remove)
+			}
+		}
+
+		if d.HasChange(names.AttrStatus) {
+			input.Status = aws.String(d.Get(names.AttrStatus).(string))
+		}
+
+		_, err := conn.UpdateModelManifestWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Model Manifest (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitModelManifestValidated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT FleetWise Model Manifest (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceModelManifestRead(ctx, d, meta)...)
+}
+
+func resourceModelManifestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT FleetWise Model Manifest: %s", d.Id())
+	_, err := conn.DeleteModelManifestWithContext(ctx, &iotfleetwise.DeleteModelManifestInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotfleetwise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT FleetWise Model Manifest (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindModelManifestByName(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) (*iotfleetwise.GetModelManifestOutput, error) {
+	input := &iotfleetwise.GetModelManifestInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetModelManifestWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotfleetwise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findModelManifestNodesByName(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) ([]*iotfleetwise.Node, error) {
+	input := &iotfleetwise.ListModelManifestNodesInput{
+		Name: aws.String(name),
+	}
+	var output []*iotfleetwise.Node
+
+	err := conn.ListModelManifestNodesPagesWithContext(ctx, input, func(page *iotfleetwise.ListModelManifestNodesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Nodes...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusModelManifest(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindModelManifestByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitModelManifestValidated(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string, timeout time.Duration) (*iotfleetwise.GetModelManifestOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotfleetwise.ManifestStatusValidating},
+		Target:  []string{iotfleetwise.ManifestStatusActive, iotfleetwise.ManifestStatusDraft},
+		Refresh: statusModelManifest(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotfleetwise.GetModelManifestOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
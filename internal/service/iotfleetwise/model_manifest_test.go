@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotfleetwise "github.com/hashicorp/terraform-provider-aws/internal/service/iotfleetwise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccModelManifest_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetModelManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_model_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelManifestConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelManifestExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotfleetwise", "model-manifest/"+rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "nodes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "nodes.*", "Vehicle.Speed"),
+					resource.TestCheckResourceAttrPair(resourceName, "signal_catalog_arn", "aws_iotfleetwise_signal_catalog.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccModelManifest_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetModelManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_model_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelManifestConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelManifestExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotfleetwise.ResourceModelManifest(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccModelManifest_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetModelManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_model_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelManifestConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelManifestExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DRAFT"),
+				),
+			},
+			{
+				Config: testAccModelManifestConfig_basic(rName, "ACTIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelManifestExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckModelManifestExists(ctx context.Context, n string, v *iotfleetwise.GetModelManifestOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+		output, err := tfiotfleetwise.FindModelManifestByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckModelManifestDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotfleetwise_model_manifest" {
+				continue
+			}
+
+			_, err := tfiotfleetwise.FindModelManifestByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT FleetWise Model Manifest %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccModelManifestConfig_basic(rName, status string) string {
+	return acctest.ConfigCompose(testAccSignalCatalogConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iotfleetwise_model_manifest" "test" {
+  name               = %[1]q
+  signal_catalog_arn = aws_iotfleetwise_signal_catalog.test.arn
+  nodes              = ["Vehicle.Speed"]
+  status             = %[2]q
+}
+`, rName, status))
+}
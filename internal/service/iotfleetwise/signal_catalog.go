@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotfleetwise_signal_catalog", name="Signal Catalog")
+// @Tags(identifierAttribute="arn")
+func ResourceSignalCatalog() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSignalCatalogCreate,
+		ReadWithoutTimeout:   resourceSignalCatalogRead,
+		UpdateWithoutTimeout: resourceSignalCatalogUpdate,
+		DeleteWithoutTimeout: resourceSignalCatalogDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validNameFunc(),
+			},
+			"nodes": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validJSONList[iotfleetwise.Node](),
+				DiffSuppressFunc: suppressEquivalentJSONListDiffs(nodeFullyQualifiedName, nil),
+				StateFunc: func(v interface{}) string {
+					json, _ := jsonListCanonicalJSON(v.(string), nodeFullyQualifiedName, nil)
+					return json
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSignalCatalogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotfleetwise.CreateSignalCatalogInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("nodes"); ok {
+		nodes, err := expandJSONList[iotfleetwise.Node](v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT FleetWise Signal Catalog (%s): nodes: %s", name, err)
+		}
+
+		input.Nodes = nodes
+	}
+
+	_, err := conn.CreateSignalCatalogWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT FleetWise Signal Catalog (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceSignalCatalogRead(ctx, d, meta)...)
+}
+
+func resourceSignalCatalogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	output, err := FindSignalCatalogByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT FleetWise Signal Catalog (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Signal Catalog (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrName, output.Name)
+
+	nodes, err := findSignalCatalogNodesByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Signal Catalog (%s) nodes: %s", d.Id(), err)
+	}
+
+	v, err := flattenJSONList(nodes, nodeFullyQualifiedName, nil)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Signal Catalog (%s): %s", d.Id(), err)
+	}
+	d.Set("nodes", v)
+
+	return diags
+}
+
+func resourceSignalCatalogUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotfleetwise.UpdateSignalCatalogInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("nodes") {
+			o, n := d.GetChange("nodes")
+			oldNodes, err := expandJSONList[iotfleetwise.Node](o.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Signal Catalog (%s): nodes: %s", d.Id(), err)
+			}
+			newNodes, err := expandJSONList[iotfleetwise.Node](n.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Signal Catalog (%s): nodes: %s", d.Id(), err)
+			}
+
+			input.NodesToAdd, input.NodesToUpdate, input.NodesToRemove = diffJSONLists(oldNodes, newNodes, nodeFullyQualifiedName)
+		}
+
+		_, err := conn.UpdateSignalCatalogWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Signal Catalog (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSignalCatalogRead(ctx, d, meta)...)
+}
+
+func resourceSignalCatalogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT FleetWise Signal Catalog: %s", d.Id())
+	_, err := conn.DeleteSignalCatalogWithContext(ctx, &iotfleetwise.DeleteSignalCatalogInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotfleetwise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT FleetWise Signal Catalog (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindSignalCatalogByName(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) (*iotfleetwise.GetSignalCatalogOutput, error) {
+	input := &iotfleetwise.GetSignalCatalogInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetSignalCatalogWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotfleetwise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findSignalCatalogNodesByName(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) ([]*iotfleetwise.Node, error) {
+	input := &iotfleetwise.ListSignalCatalogNodesInput{
+		Name: aws.String(name),
+	}
+	var output []*iotfleetwise.Node
+
+	err := conn.ListSignalCatalogNodesPagesWithContext(ctx, input, func(page *iotfleetwise.ListSignalCatalogNodesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Nodes...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func nodeFullyQualifiedName(apiObject *iotfleetwise.Node) string {
+	switch {
+	case apiObject.Actuator != nil:
+		return aws.StringValue(apiObject.Actuator.FullyQualifiedName)
+	case apiObject.Attribute != nil:
+		return aws.StringValue(apiObject.Attribute.FullyQualifiedName)
+	case apiObject.Branch != nil:
+		return aws.StringValue(apiObject.Branch.FullyQualifiedName)
+	case apiObject.Property != nil:
+		return aws.StringValue(apiObject.Property.FullyQualifiedName)
+	case apiObject.Sensor != nil:
+		return aws.StringValue(apiObject.Sensor.FullyQualifiedName)
+	case apiObject.Struct != nil:
+		return aws.StringValue(apiObject.Struct.FullyQualifiedName)
+	}
+
+	return ""
+}
+
+func validJSONList[T any]() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		if _, err := expandJSONList[T](v.(string)); err != nil {
+			errors = append(errors, fmt.Errorf("%q is invalid: %s", k, err))
+		}
+
+		return
+	}
+}
+
+// suppressEquivalentJSONListDiffs compares the canonical form of both values
+// so that element ordering, key ordering and whitespace don't produce a diff.
+func suppressEquivalentJSONListDiffs[T any](key func(*T) string, normalize func(*T)) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		oldJSON, err := jsonListCanonicalJSON(old, key, normalize)
+		if err != nil {
+			return false
+		}
+
+		newJSON, err := jsonListCanonicalJSON(new, key, normalize)
+		if err != nil {
+			return false
+		}
+
+		return oldJSON == newJSON
+	}
+}
+
+func jsonListCanonicalJSON[T any](v string, key func(*T) string, normalize func(*T)) (string, error) {
+	if v == "" {
+		return v, nil
+	}
+
+	apiObjects, err := expandJSONList[T](v)
+	if err != nil {
+		return v, err
+	}
+
+	return flattenJSONList(apiObjects, key, normalize)
+}
+
+func expandJSONList[T any](v string) ([]*T, error) {
+	var apiObjects []*T
+
+	if v == "" {
+		return apiObjects, nil
+	}
+
+	if err := json.Unmarshal([]byte(v), &apiObjects); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %s", err)
+	}
+
+	return apiObjects, nil
+}
+
+// flattenJSONList serializes the API objects sorted by key.
+func flattenJSONList[T any](apiObjects []*T, key func(*T) string, normalize func(*T)) (string, error) {
+	if len(apiObjects) == 0 {
+		return "", nil
+	}
+
+	if normalize != nil {
+		for _, v := range apiObjects {
+			normalize(v)
+		}
+	}
+
+	apiObjects = append([]*T(nil), apiObjects...)
+	sort.SliceStable(apiObjects, func(i, j int) bool {
+		return key(apiObjects[i]) < key(apiObjects[j])
+	})
+
+	b, err := jsonutil.BuildJSON(apiObjects)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// diffJSONLists returns the API objects to add, update and the keys of those to remove.
+func diffJSONLists[T any](oldAPIObjects, newAPIObjects []*T, key func(*T) string) ([]*T, []*T, []*string) {
+	var add, update []*T
+	var remove []*string
+
+	oldByKey := make(map[string]*T)
+	for _, v := range oldAPIObjects {
+		oldByKey[key(v)] = v
+	}
+
+	newByKey := make(map[string]*T)
+	for _, v := range newAPIObjects {
+		k := key(v)
+		newByKey[k] = v
+
+		if old, ok := oldByKey[k]; !ok {
+			add = append(add, v)
+		} else if !reflect.DeepEqual(old, v) {
+			update = append(update, v)
+		}
+	}
+
+	for _, v := range oldAPIObjects {
+		if k := key(v); newByKey[k] == nil {
+			remove = append(remove, aws.String(k))
+		}
+	}
+
+	return add, update, remove
+}
+
+func validNameFunc() schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, 100),
+		validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_:-]+$`), "must contain only alphanumeric characters, colons, underscores and hyphens"),
+	)
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotfleetwise "github.com/hashicorp/terraform-provider-aws/internal/service/iotfleetwise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSignalCatalog_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetSignalCatalogOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_signal_catalog.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalCatalogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalCatalogConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotfleetwise", "signal-catalog/"+rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "nodes"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSignalCatalog_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetSignalCatalogOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_signal_catalog.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalCatalogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalCatalogConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotfleetwise.ResourceSignalCatalog(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSignalCatalog_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetSignalCatalogOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_signal_catalog.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalCatalogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalCatalogConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSignalCatalogConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccSignalCatalogConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccSignalCatalog_nodes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetSignalCatalogOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_signal_catalog.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalCatalogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalCatalogConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccSignalCatalogConfig_nodesUpdated(rName, "Line 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Line 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSignalCatalogConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSignalCatalogExists(ctx context.Context, n string, v *iotfleetwise.GetSignalCatalogOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+		output, err := tfiotfleetwise.FindSignalCatalogByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSignalCatalogDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotfleetwise_signal_catalog" {
+				continue
+			}
+
+			_, err := tfiotfleetwise.FindSignalCatalogByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT FleetWise Signal Catalog %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccSignalCatalogConfig_nodes = `
+  nodes = jsonencode([
+    {
+      branch = {
+        fullyQualifiedName = "Vehicle"
+      }
+    },
+    {
+      sensor = {
+        fullyQualifiedName = "Vehicle.Speed"
+        dataType           = "DOUBLE"
+        unit               = "km/h"
+      }
+    },
+  ])
+`
+
+func testAccSignalCatalogConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotfleetwise_signal_catalog" "test" {
+  name = %[1]q
+%[2]s
+}
+`, rName, testAccSignalCatalogConfig_nodes)
+}
+
+func testAccSignalCatalogConfig_nodesUpdated(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_iotfleetwise_signal_catalog" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  nodes = jsonencode([
+    {
+      branch = {
+        fullyQualifiedName = "Vehicle"
+      }
+    },
+    {
+      sensor = {
+        fullyQualifiedName = "Vehicle.Speed"
+        dataType           = "DOUBLE"
+        unit               = "mph"
+      }
+    },
+    {
+      attribute = {
+        fullyQualifiedName = "Vehicle.Make"
+        dataType           = "STRING"
+        defaultValue       = "Example"
+      }
+    },
+  ])
+}
+`, rName, description)
+}
+
+func testAccSignalCatalogConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotfleetwise_signal_catalog" "test" {
+  name = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccSignalCatalogConfig_nodes, tagKey1, tagValue1)
+}
+
+func testAccSignalCatalogConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotfleetwise_signal_catalog" "test" {
+  name = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccSignalCatalogConfig_nodes, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_iotfleetwise_campaign", &resource.Sweeper{
+		Name: "aws_iotfleetwise_campaign",
+		F:    sweepCampaigns,
+	})
+
+	resource.AddTestSweepers("aws_iotfleetwise_decoder_manifest", &resource.Sweeper{
+		Name: "aws_iotfleetwise_decoder_manifest",
+		F:    sweepDecoderManifests,
+		Dependencies: []string{
+			"aws_iotfleetwise_vehicle",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iotfleetwise_fleet", &resource.Sweeper{
+		Name: "aws_iotfleetwise_fleet",
+		F:    sweepFleets,
+		Dependencies: []string{
+			"aws_iotfleetwise_campaign",
+			"aws_iotfleetwise_vehicle",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iotfleetwise_model_manifest", &resource.Sweeper{
+		Name: "aws_iotfleetwise_model_manifest",
+		F:    sweepModelManifests,
+		Dependencies: []string{
+			"aws_iotfleetwise_decoder_manifest",
+			"aws_iotfleetwise_vehicle",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iotfleetwise_signal_catalog", &resource.Sweeper{
+		Name: "aws_iotfleetwise_signal_catalog",
+		F:    sweepSignalCatalogs,
+		Dependencies: []string{
+			"aws_iotfleetwise_campaign",
+			"aws_iotfleetwise_fleet",
+			"aws_iotfleetwise_model_manifest",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iotfleetwise_vehicle", &resource.Sweeper{
+		Name: "aws_iotfleetwise_vehicle",
+		F:    sweepVehicles,
+		Dependencies: []string{
+			"aws_iotfleetwise_campaign",
+		},
+	})
+}
+
+func sweepCampaigns(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTFleetWiseConn(ctx)
+	input := &iotfleetwise.ListCampaignsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListCampaignsPagesWithContext(ctx, input, func(page *iotfleetwise.ListCampaignsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CampaignSummaries {
+			r := ResourceCampaign()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT FleetWise Campaign sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT FleetWise Campaigns (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT FleetWise Campaigns (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDecoderManifests(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTFleetWiseConn(ctx)
+	input := &iotfleetwise.ListDecoderManifestsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDecoderManifestsPagesWithContext(ctx, input, func(page *iotfleetwise.ListDecoderManifestsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Summaries {
+			r := ResourceDecoderManifest()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT FleetWise Decoder Manifest sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT FleetWise Decoder Manifests (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT FleetWise Decoder Manifests (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepFleets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTFleetWiseConn(ctx)
+	input := &iotfleetwise.ListFleetsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListFleetsPagesWithContext(ctx, input, func(page *iotfleetwise.ListFleetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FleetSummaries {
+			r := ResourceFleet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT FleetWise Fleet sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT FleetWise Fleets (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT FleetWise Fleets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepModelManifests(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTFleetWiseConn(ctx)
+	input := &iotfleetwise.ListModelManifestsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListModelManifestsPagesWithContext(ctx, input, func(page *iotfleetwise.ListModelManifestsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Summaries {
+			r := ResourceModelManifest()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT FleetWise Model Manifest sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT FleetWise Model Manifests (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT FleetWise Model Manifests (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSignalCatalogs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTFleetWiseConn(ctx)
+	input := &iotfleetwise.ListSignalCatalogsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListSignalCatalogsPagesWithContext(ctx, input, func(page *iotfleetwise.ListSignalCatalogsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Summaries {
+			r := ResourceSignalCatalog()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT FleetWise Signal Catalog sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT FleetWise Signal Catalogs (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT FleetWise Signal Catalogs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepVehicles(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTFleetWiseConn(ctx)
+	input := &iotfleetwise.ListVehiclesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListVehiclesPagesWithContext(ctx, input, func(page *iotfleetwise.ListVehiclesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VehicleSummaries {
+			r := ResourceVehicle()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VehicleName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT FleetWise Vehicle sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT FleetWise Vehicles (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT FleetWise Vehicles (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotfleetwise_vehicle", name="Vehicle")
+// @Tags(identifierAttribute="arn")
+func ResourceVehicle() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVehicleCreate,
+		ReadWithoutTimeout:   resourceVehicleRead,
+		UpdateWithoutTimeout: resourceVehicleUpdate,
+		DeleteWithoutTimeout: resourceVehicleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(iotfleetwise.VehicleAssociationBehavior_Values(), false),
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"decoder_manifest_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"model_manifest_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vehicle_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validNameFunc(),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVehicleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	name := d.Get("vehicle_name").(string)
+	input := &iotfleetwise.CreateVehicleInput{
+		DecoderManifestArn: aws.String(d.Get("decoder_manifest_arn").(string)),
+		ModelManifestArn:   aws.String(d.Get("model_manifest_arn").(string)),
+		Tags:               getTagsIn(ctx),
+		VehicleName:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("association_behavior"); ok {
+		input.AssociationBehavior = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("attributes"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Attributes = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	_, err := conn.CreateVehicleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT FleetWise Vehicle (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceVehicleRead(ctx, d, meta)...)
+}
+
+func resourceVehicleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	output, err := FindVehicleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT FleetWise Vehicle (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT FleetWise Vehicle (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set("attributes", aws.StringValueMap(output.Attributes))
+	d.Set("decoder_manifest_arn", output.DecoderManifestArn)
+	d.Set("model_manifest_arn", output.ModelManifestArn)
+	d.Set("vehicle_name", output.VehicleName)
+
+	return diags
+}
+
+func resourceVehicleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotfleetwise.UpdateVehicleInput{
+			VehicleName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("attributes") {
+			input.AttributeUpdateMode = aws.String(iotfleetwise.UpdateModeOverwrite)
+			input.Attributes = flex.ExpandStringMap(d.Get("attributes").(map[string]interface{}))
+		}
+
+		if d.HasChange("decoder_manifest_arn") {
+			input.DecoderManifestArn = aws.String(d.Get("decoder_manifest_arn").(string))
+		}
+
+		if d.HasChange("model_manifest_arn") {
+			input.ModelManifestArn = aws.String(d.Get("model_manifest_arn").(string))
+		}
+
+		_, err := conn.UpdateVehicleWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT FleetWise Vehicle (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceVehicleRead(ctx, d, meta)...)
+}
+
+func resourceVehicleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT FleetWise Vehicle: %s", d.Id())
+	_, err := conn.DeleteVehicleWithContext(ctx, &iotfleetwise.DeleteVehicleInput{
+		VehicleName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotfleetwise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT FleetWise Vehicle (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindVehicleByName(ctx context.Context, conn *iotfleetwise.IoTFleetWise, name string) (*iotfleetwise.GetVehicleOutput, error) {
+	input := &iotfleetwise.GetVehicleInput{
+		VehicleName: aws.String(name),
+	}
+
+	output, err := conn.GetVehicleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotfleetwise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotfleetwise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotfleetwise "github.com/hashicorp/terraform-provider-aws/internal/service/iotfleetwise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccVehicle_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetVehicleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_vehicle.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVehicleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVehicleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVehicleExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotfleetwise", "vehicle/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "association_behavior", "CreateIotThing"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "decoder_manifest_arn", "aws_iotfleetwise_decoder_manifest.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "model_manifest_arn", "aws_iotfleetwise_model_manifest.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "vehicle_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"association_behavior"},
+			},
+		},
+	})
+}
+
+func testAccVehicle_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetVehicleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_vehicle.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVehicleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVehicleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVehicleExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotfleetwise.ResourceVehicle(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccVehicle_attributes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetVehicleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_vehicle.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVehicleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVehicleConfig_attributes(rName, "Example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVehicleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "attributes.Vehicle.Make", "Example"),
+				),
+			},
+			{
+				Config: testAccVehicleConfig_attributes(rName, "Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVehicleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "attributes.Vehicle.Make", "Updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVehicleExists(ctx context.Context, n string, v *iotfleetwise.GetVehicleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+		output, err := tfiotfleetwise.FindVehicleByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVehicleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotfleetwise_vehicle" {
+				continue
+			}
+
+			_, err := tfiotfleetwise.FindVehicleByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT FleetWise Vehicle %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVehicleConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDecoderManifestConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iotfleetwise_vehicle" "test" {
+  vehicle_name         = %[1]q
+  model_manifest_arn   = aws_iotfleetwise_model_manifest.test.arn
+  decoder_manifest_arn = aws_iotfleetwise_decoder_manifest.test.arn
+  association_behavior = "CreateIotThing"
+}
+`, rName))
+}
+
+func testAccVehicleConfig_attributes(rName, make string) string {
+	return acctest.ConfigCompose(testAccDecoderManifestConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iotfleetwise_vehicle" "test" {
+  vehicle_name         = %[1]q
+  model_manifest_arn   = aws_iotfleetwise_model_manifest.test.arn
+  decoder_manifest_arn = aws_iotfleetwise_decoder_manifest.test.arn
+  association_behavior = "CreateIotThing"
+
+  attributes = {
+    "Vehicle.Make" = %[2]q
+  }
+}
+`, rName, make))
+}
@@ -0,0 +1,540 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotsitewise_asset", name="Asset")
+// @Tags(identifierAttribute="arn")
+func ResourceAsset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetCreate,
+		ReadWithoutTimeout:   resourceAssetRead,
+		UpdateWithoutTimeout: resourceAssetUpdate,
+		DeleteWithoutTimeout: resourceAssetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_model_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			names.AttrExternalID: externalIDSchema(),
+			"hierarchy_association": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"child_asset_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"hierarchy_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"property": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAlias: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"notification_state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      iotsitewise.PropertyNotificationStateDisabled,
+							ValidateFunc: validation.StringInSlice(iotsitewise.PropertyNotificationState_Values(), false),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAssetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotsitewise.CreateAssetInput{
+		AssetModelId: aws.String(d.Get("asset_model_id").(string)),
+		AssetName:    aws.String(name),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.AssetDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrExternalID); ok {
+		input.AssetExternalId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAssetWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Asset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssetId))
+
+	if _, err := waitAssetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("property"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateAssetProperties(ctx, conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("hierarchy_association"); ok && v.(*schema.Set).Len() > 0 {
+		if err := associateAssets(ctx, conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAssetRead(ctx, d, meta)...)
+}
+
+func resourceAssetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	output, err := FindAssetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Asset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.AssetArn)
+	d.Set("asset_model_id", output.AssetModelId)
+	d.Set(names.AttrDescription, output.AssetDescription)
+	d.Set(names.AttrExternalID, output.AssetExternalId)
+	d.Set(names.AttrName, output.AssetName)
+	if err := d.Set("property", flattenAssetProperties(output.AssetProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting property: %s", err)
+	}
+
+	var associations []interface{}
+	for _, v := range output.AssetHierarchies {
+		hierarchyID := aws.StringValue(v.Id)
+		children, err := findAssociatedChildAssets(ctx, conn, d.Id(), hierarchyID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset (%s) hierarchy (%s) associations: %s", d.Id(), hierarchyID, err)
+		}
+
+		for _, v := range children {
+			associations = append(associations, map[string]interface{}{
+				"child_asset_id": aws.StringValue(v.Id),
+				"hierarchy_id":   hierarchyID,
+			})
+		}
+	}
+	if err := d.Set("hierarchy_association", associations); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hierarchy_association: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAssetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	if d.HasChanges(names.AttrDescription, names.AttrExternalID, names.AttrName) {
+		input := &iotsitewise.UpdateAssetInput{
+			AssetId:   aws.String(d.Id()),
+			AssetName: aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.AssetDescription = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(names.AttrExternalID); ok {
+			input.AssetExternalId = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateAssetWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("property") {
+		o, n := d.GetChange("property")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Properties dropped from configuration have their alias removed and notifications disabled.
+		var del []interface{}
+		for _, tfMapRaw := range os.Difference(ns).List() {
+			id := tfMapRaw.(map[string]interface{})[names.AttrID].(string)
+			if !assetPropertySetContainsID(ns, id) {
+				del = append(del, map[string]interface{}{
+					names.AttrID: id,
+				})
+			}
+		}
+
+		if err := updateAssetProperties(ctx, conn, d.Id(), del); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+
+		if err := updateAssetProperties(ctx, conn, d.Id(), ns.Difference(os).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("hierarchy_association") {
+		o, n := d.GetChange("hierarchy_association")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if err := disassociateAssets(ctx, conn, d.Id(), os.Difference(ns).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+
+		if err := associateAssets(ctx, conn, d.Id(), ns.Difference(os).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAssetRead(ctx, d, meta)...)
+}
+
+func resourceAssetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	// An asset can't be deleted while it still has child assets associated.
+	if v, ok := d.GetOk("hierarchy_association"); ok && v.(*schema.Set).Len() > 0 {
+		if err := disassociateAssets(ctx, conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Asset: %s", d.Id())
+	_, err := conn.DeleteAssetWithContext(ctx, &iotsitewise.DeleteAssetInput{
+		AssetId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Asset (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateAssetProperties(ctx context.Context, conn *iotsitewise.IoTSiteWise, assetID string, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		propertyID := tfMap[names.AttrID].(string)
+		input := &iotsitewise.UpdateAssetPropertyInput{
+			AssetId:    aws.String(assetID),
+			PropertyId: aws.String(propertyID),
+		}
+
+		if v, ok := tfMap[names.AttrAlias].(string); ok && v != "" {
+			input.PropertyAlias = aws.String(v)
+		}
+
+		if v, ok := tfMap["notification_state"].(string); ok && v != "" {
+			input.PropertyNotificationState = aws.String(v)
+		}
+
+		_, err := conn.UpdateAssetPropertyWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("updating property (%s): %w", propertyID, err)
+		}
+	}
+
+	return nil
+}
+
+func associateAssets(ctx context.Context, conn *iotsitewise.IoTSiteWise, assetID string, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		childAssetID, hierarchyID := tfMap["child_asset_id"].(string), tfMap["hierarchy_id"].(string)
+		_, err := conn.AssociateAssetsWithContext(ctx, &iotsitewise.AssociateAssetsInput{
+			AssetId:      aws.String(assetID),
+			ChildAssetId: aws.String(childAssetID),
+			HierarchyId:  aws.String(hierarchyID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("associating child asset (%s) with hierarchy (%s): %w", childAssetID, hierarchyID, err)
+		}
+	}
+
+	return nil
+}
+
+func disassociateAssets(ctx context.Context, conn *iotsitewise.IoTSiteWise, assetID string, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		childAssetID, hierarchyID := tfMap["child_asset_id"].(string), tfMap["hierarchy_id"].(string)
+		_, err := conn.DisassociateAssetsWithContext(ctx, &iotsitewise.DisassociateAssetsInput{
+			AssetId:      aws.String(assetID),
+			ChildAssetId: aws.String(childAssetID),
+			HierarchyId:  aws.String(hierarchyID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("disassociating child asset (%s) from hierarchy (%s): %w", childAssetID, hierarchyID, err)
+		}
+	}
+
+	return nil
+}
+
+func assetPropertySetContainsID(s *schema.Set, id string) bool {
+	for _, tfMapRaw := range s.List() {
+		if tfMapRaw.(map[string]interface{})[names.AttrID].(string) == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func FindAssetByID(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) (*iotsitewise.DescribeAssetOutput, error) {
+	input := &iotsitewise.DescribeAssetInput{
+		AssetId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAssetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AssetStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findAssociatedChildAssets(ctx context.Context, conn *iotsitewise.IoTSiteWise, assetID, hierarchyID string) ([]*iotsitewise.AssociatedAssetsSummary, error) {
+	input := &iotsitewise.ListAssociatedAssetsInput{
+		AssetId:            aws.String(assetID),
+		HierarchyId:        aws.String(hierarchyID),
+		TraversalDirection: aws.String(iotsitewise.TraversalDirectionChild),
+	}
+	var output []*iotsitewise.AssociatedAssetsSummary
+
+	err := conn.ListAssociatedAssetsPagesWithContext(ctx, input, func(page *iotsitewise.ListAssociatedAssetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssetSummaries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusAsset(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAssetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssetStatus.State), nil
+	}
+}
+
+func waitAssetActive(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetStateCreating, iotsitewise.AssetStateUpdating},
+		Target:  []string{iotsitewise.AssetStateActive},
+		Refresh: statusAsset(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetOutput); ok {
+		if v := output.AssetStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetStateActive, iotsitewise.AssetStateDeleting},
+		Target:  []string{},
+		Refresh: statusAsset(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetOutput); ok {
+		if v := output.AssetStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenAssetProperties(apiObjects []*iotsitewise.AssetProperty) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || aws.StringValue(apiObject.Alias) == "" {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrAlias:      aws.StringValue(apiObject.Alias),
+			names.AttrID:         aws.StringValue(apiObject.Id),
+			"notification_state": iotsitewise.PropertyNotificationStateDisabled,
+		}
+
+		if v := apiObject.Notification; v != nil {
+			tfMap["notification_state"] = aws.StringValue(v.State)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,957 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotsitewise_asset_model", name="Asset Model")
+// @Tags(identifierAttribute="arn")
+func ResourceAssetModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetModelCreate,
+		ReadWithoutTimeout:   resourceAssetModelRead,
+		UpdateWithoutTimeout: resourceAssetModelUpdate,
+		DeleteWithoutTimeout: resourceAssetModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_model_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(iotsitewise.AssetModelType_Values(), false),
+			},
+			"composite_model": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						names.AttrExternalID: externalIDSchema(),
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"property": assetModelPropertySchema(),
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			names.AttrExternalID: externalIDSchema(),
+			"hierarchy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"child_asset_model_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrExternalID: externalIDSchema(),
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"property":        assetModelPropertySchema(),
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func externalIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(2, 128),
+	}
+}
+
+func assetModelPropertySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"data_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(iotsitewise.PropertyDataType_Values(), false),
+				},
+				"data_type_spec": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				names.AttrExternalID: externalIDSchema(),
+				names.AttrID: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				names.AttrType: {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"attribute": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrDefaultValue: {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"measurement": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{},
+								},
+							},
+							"metric": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrExpression: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"variable": expressionVariableSchema(),
+										"window": {
+											Type:     schema.TypeList,
+											Required: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"tumbling": {
+														Type:     schema.TypeList,
+														Required: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																names.AttrInterval: {
+																	Type:     schema.TypeString,
+																	Required: true,
+																},
+																"offset": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																	Computed: true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"transform": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrExpression: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"variable": expressionVariableSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
+				names.AttrUnit: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+		},
+	}
+}
+
+func expressionVariableSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrValue: {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hierarchy_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"property_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAssetModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotsitewise.CreateAssetModelInput{
+		AssetModelName: aws.String(name),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("asset_model_type"); ok {
+		input.AssetModelType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("composite_model"); ok && len(v.([]interface{})) > 0 {
+		input.AssetModelCompositeModels = expandAssetModelCompositeModelDefinitions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.AssetModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrExternalID); ok {
+		input.AssetModelExternalId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hierarchy"); ok && len(v.([]interface{})) > 0 {
+		input.AssetModelHierarchies = expandAssetModelHierarchyDefinitions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("property"); ok && len(v.([]interface{})) > 0 {
+		input.AssetModelProperties = expandAssetModelPropertyDefinitions(v.([]interface{}))
+	}
+
+	output, err := conn.CreateAssetModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Asset Model (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssetModelId))
+
+	if _, err := waitAssetModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAssetModelRead(ctx, d, meta)...)
+}
+
+func resourceAssetModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	output, err := FindAssetModelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Asset Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	// Expression variables that point at this model's own properties and
+	// hierarchies are reported by ID; map them back to the names used in configuration.
+	localNames := make(map[string]string)
+	for _, v := range output.AssetModelProperties {
+		localNames[aws.StringValue(v.Id)] = aws.StringValue(v.Name)
+	}
+	for _, v := range output.AssetModelHierarchies {
+		localNames[aws.StringValue(v.Id)] = aws.StringValue(v.Name)
+	}
+
+	d.Set(names.AttrARN, output.AssetModelArn)
+	d.Set("asset_model_type", output.AssetModelType)
+	if err := d.Set("composite_model", flattenAssetModelCompositeModels(output.AssetModelCompositeModels, localNames)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting composite_model: %s", err)
+	}
+	d.Set(names.AttrDescription, output.AssetModelDescription)
+	d.Set(names.AttrExternalID, output.AssetModelExternalId)
+	if err := d.Set("hierarchy", flattenAssetModelHierarchies(output.AssetModelHierarchies)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hierarchy: %s", err)
+	}
+	d.Set(names.AttrName, output.AssetModelName)
+	if err := d.Set("property", flattenAssetModelProperties(output.AssetModelProperties, localNames)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting property: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAssetModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		current, err := FindAssetModelByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+		}
+
+		// UpdateAssetModel replaces the full model definition. Elements that keep
+		// their name must be sent with their existing IDs or they are recreated.
+		propertyIDs := make(map[string]string)
+		for _, v := range current.AssetModelProperties {
+			propertyIDs[aws.StringValue(v.Name)] = aws.StringValue(v.Id)
+		}
+		hierarchyIDs := make(map[string]string)
+		for _, v := range current.AssetModelHierarchies {
+			hierarchyIDs[aws.StringValue(v.Name)] = aws.StringValue(v.Id)
+		}
+		compositeModels := make(map[string]*iotsitewise.AssetModelCompositeModel)
+		for _, v := range current.AssetModelCompositeModels {
+			compositeModels[aws.StringValue(v.Name)] = v
+		}
+
+		input := &iotsitewise.UpdateAssetModelInput{
+			AssetModelCompositeModels: expandAssetModelCompositeModels(d.Get("composite_model").([]interface{}), compositeModels),
+			AssetModelHierarchies:     expandAssetModelHierarchies(d.Get("hierarchy").([]interface{}), hierarchyIDs),
+			AssetModelId:              aws.String(d.Id()),
+			AssetModelName:            aws.String(d.Get(names.AttrName).(string)),
+			AssetModelProperties:      expandAssetModelProperties(d.Get("property").([]interface{}), propertyIDs),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.AssetModelDescription = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(names.AttrExternalID); ok {
+			input.AssetModelExternalId = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateAssetModelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAssetModelRead(ctx, d, meta)...)
+}
+
+func resourceAssetModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Asset Model: %s", d.Id())
+	_, err := conn.DeleteAssetModelWithContext(ctx, &iotsitewise.DeleteAssetModelInput{
+		AssetModelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssetModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAssetModelByID(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) (*iotsitewise.DescribeAssetModelOutput, error) {
+	input := &iotsitewise.DescribeAssetModelInput{
+		AssetModelId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAssetModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AssetModelStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAssetModel(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAssetModelByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssetModelStatus.State), nil
+	}
+}
+
+func waitAssetModelActive(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateCreating, iotsitewise.AssetModelStatePropagating, iotsitewise.AssetModelStateUpdating},
+		Target:  []string{iotsitewise.AssetModelStateActive},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		if v := output.AssetModelStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetModelDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateActive, iotsitewise.AssetModelStateDeleting},
+		Target:  []string{},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		if v := output.AssetModelStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAssetModelPropertyDefinitions(tfList []interface{}) []*iotsitewise.AssetModelPropertyDefinition {
+	var apiObjects []*iotsitewise.AssetModelPropertyDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.AssetModelPropertyDefinition{
+			DataType: aws.String(tfMap["data_type"].(string)),
+			Name:     aws.String(tfMap[names.AttrName].(string)),
+			Type:     expandPropertyType(tfMap[names.AttrType].([]interface{})),
+		}
+
+		if v, ok := tfMap["data_type_spec"].(string); ok && v != "" {
+			apiObject.DataTypeSpec = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrExternalID].(string); ok && v != "" {
+			apiObject.ExternalId = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
+			apiObject.Unit = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelProperties(tfList []interface{}, ids map[string]string) []*iotsitewise.AssetModelProperty {
+	var apiObjects []*iotsitewise.AssetModelProperty
+
+	for _, v := range expandAssetModelPropertyDefinitions(tfList) {
+		apiObject := &iotsitewise.AssetModelProperty{
+			DataType:     v.DataType,
+			DataTypeSpec: v.DataTypeSpec,
+			ExternalId:   v.ExternalId,
+			Name:         v.Name,
+			Type:         v.Type,
+			Unit:         v.Unit,
+		}
+
+		if id, ok := ids[aws.StringValue(v.Name)]; ok {
+			apiObject.Id = aws.String(id)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPropertyType(tfList []interface{}) *iotsitewise.PropertyType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotsitewise.PropertyType{}
+
+	if v, ok := tfMap["attribute"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Attribute = &iotsitewise.Attribute{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap[names.AttrDefaultValue].(string); ok && v != "" {
+				apiObject.Attribute.DefaultValue = aws.String(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["measurement"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Measurement = &iotsitewise.Measurement{}
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Metric = &iotsitewise.Metric{
+			Expression: aws.String(tfMap[names.AttrExpression].(string)),
+			Variables:  expandExpressionVariables(tfMap["variable"].([]interface{})),
+			Window:     expandMetricWindow(tfMap["window"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["transform"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Transform = &iotsitewise.Transform{
+			Expression: aws.String(tfMap[names.AttrExpression].(string)),
+			Variables:  expandExpressionVariables(tfMap["variable"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandExpressionVariables(tfList []interface{}) []*iotsitewise.ExpressionVariable {
+	var apiObjects []*iotsitewise.ExpressionVariable
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.ExpressionVariable{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Value: &iotsitewise.VariableValue{},
+		}
+
+		if v, ok := tfMap[names.AttrValue].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["hierarchy_id"].(string); ok && v != "" {
+				apiObject.Value.HierarchyId = aws.String(v)
+			}
+
+			if v, ok := tfMap["property_id"].(string); ok && v != "" {
+				apiObject.Value.PropertyId = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMetricWindow(tfList []interface{}) *iotsitewise.MetricWindow {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotsitewise.MetricWindow{}
+
+	if v, ok := tfMap["tumbling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Tumbling = &iotsitewise.TumblingWindow{
+			Interval: aws.String(tfMap[names.AttrInterval].(string)),
+		}
+
+		if v, ok := tfMap["offset"].(string); ok && v != "" {
+			apiObject.Tumbling.Offset = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandAssetModelHierarchyDefinitions(tfList []interface{}) []*iotsitewise.AssetModelHierarchyDefinition {
+	var apiObjects []*iotsitewise.AssetModelHierarchyDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.AssetModelHierarchyDefinition{
+			ChildAssetModelId: aws.String(tfMap["child_asset_model_id"].(string)),
+			Name:              aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrExternalID].(string); ok && v != "" {
+			apiObject.ExternalId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelHierarchies(tfList []interface{}, ids map[string]string) []*iotsitewise.AssetModelHierarchy {
+	var apiObjects []*iotsitewise.AssetModelHierarchy
+
+	for _, v := range expandAssetModelHierarchyDefinitions(tfList) {
+		apiObject := &iotsitewise.AssetModelHierarchy{
+			ChildAssetModelId: v.ChildAssetModelId,
+			ExternalId:        v.ExternalId,
+			Name:              v.Name,
+		}
+
+		if id, ok := ids[aws.StringValue(v.Name)]; ok {
+			apiObject.Id = aws.String(id)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelCompositeModelDefinitions(tfList []interface{}) []*iotsitewise.AssetModelCompositeModelDefinition {
+	var apiObjects []*iotsitewise.AssetModelCompositeModelDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.AssetModelCompositeModelDefinition{
+			Name:       aws.String(tfMap[names.AttrName].(string)),
+			Properties: expandAssetModelPropertyDefinitions(tfMap["property"].([]interface{})),
+			Type:       aws.String(tfMap[names.AttrType].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrExternalID].(string); ok && v != "" {
+			apiObject.ExternalId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelCompositeModels(tfList []interface{}, existing map[string]*iotsitewise.AssetModelCompositeModel) []*iotsitewise.AssetModelCompositeModel {
+	var apiObjects []*iotsitewise.AssetModelCompositeModel
+
+	for i, v := range expandAssetModelCompositeModelDefinitions(tfList) {
+		propertyIDs := make(map[string]string)
+
+		apiObject := &iotsitewise.AssetModelCompositeModel{
+			Description: v.Description,
+			ExternalId:  v.ExternalId,
+			Name:        v.Name,
+			Type:        v.Type,
+		}
+
+		if current, ok := existing[aws.StringValue(v.Name)]; ok {
+			apiObject.Id = current.Id
+
+			for _, v := range current.Properties {
+				propertyIDs[aws.StringValue(v.Name)] = aws.StringValue(v.Id)
+			}
+		}
+
+		apiObject.Properties = expandAssetModelProperties(tfList[i].(map[string]interface{})["property"].([]interface{}), propertyIDs)
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAssetModelProperties(apiObjects []*iotsitewise.AssetModelProperty, localNames map[string]string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"data_type":          aws.StringValue(apiObject.DataType),
+			"data_type_spec":     aws.StringValue(apiObject.DataTypeSpec),
+			names.AttrExternalID: aws.StringValue(apiObject.ExternalId),
+			names.AttrID:         aws.StringValue(apiObject.Id),
+			names.AttrName:       aws.StringValue(apiObject.Name),
+			names.AttrType:       flattenPropertyType(apiObject.Type, localNames),
+			names.AttrUnit:       aws.StringValue(apiObject.Unit),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPropertyType(apiObject *iotsitewise.PropertyType, localNames map[string]string) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Attribute; v != nil {
+		tfMap["attribute"] = []interface{}{map[string]interface{}{
+			names.AttrDefaultValue: aws.StringValue(v.DefaultValue),
+		}}
+	}
+
+	if v := apiObject.Measurement; v != nil {
+		tfMap["measurement"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.Metric; v != nil {
+		tfMap["metric"] = []interface{}{map[string]interface{}{
+			names.AttrExpression: aws.StringValue(v.Expression),
+			"variable":           flattenExpressionVariables(v.Variables, localNames),
+			"window":             flattenMetricWindow(v.Window),
+		}}
+	}
+
+	if v := apiObject.Transform; v != nil {
+		tfMap["transform"] = []interface{}{map[string]interface{}{
+			names.AttrExpression: aws.StringValue(v.Expression),
+			"variable":           flattenExpressionVariables(v.Variables, localNames),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExpressionVariables(apiObjects []*iotsitewise.ExpressionVariable, localNames map[string]string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrName: aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.Value; v != nil {
+			hierarchyID, propertyID := aws.StringValue(v.HierarchyId), aws.StringValue(v.PropertyId)
+
+			if name, ok := localNames[hierarchyID]; ok {
+				hierarchyID = name
+			}
+
+			if name, ok := localNames[propertyID]; ok && hierarchyID == "" {
+				propertyID = name
+			}
+
+			tfMap[names.AttrValue] = []interface{}{map[string]interface{}{
+				"hierarchy_id": hierarchyID,
+				"property_id":  propertyID,
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMetricWindow(apiObject *iotsitewise.MetricWindow) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Tumbling; v != nil {
+		tfMap["tumbling"] = []interface{}{map[string]interface{}{
+			names.AttrInterval: aws.StringValue(v.Interval),
+			"offset":           aws.StringValue(v.Offset),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAssetModelHierarchies(apiObjects []*iotsitewise.AssetModelHierarchy) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"child_asset_model_id": aws.StringValue(apiObject.ChildAssetModelId),
+			names.AttrExternalID:   aws.StringValue(apiObject.ExternalId),
+			names.AttrID:           aws.StringValue(apiObject.Id),
+			names.AttrName:         aws.StringValue(apiObject.Name),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAssetModelCompositeModels(apiObjects []*iotsitewise.AssetModelCompositeModel, localNames map[string]string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrDescription: aws.StringValue(apiObject.Description),
+			names.AttrExternalID:  aws.StringValue(apiObject.ExternalId),
+			names.AttrID:          aws.StringValue(apiObject.Id),
+			names.AttrName:        aws.StringValue(apiObject.Name),
+			"property":            flattenAssetModelProperties(apiObject.Properties, localNames),
+			names.AttrType:        aws.StringValue(apiObject.Type),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
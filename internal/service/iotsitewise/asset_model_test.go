@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTSiteWiseAssetModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iotsitewise", regexache.MustCompile(`asset-model/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_type", "ASSET_MODEL"),
+					resource.TestCheckResourceAttr(resourceName, "composite_model.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "property.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "property.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "property.0.data_type", "DOUBLE"),
+					resource.TestCheckResourceAttr(resourceName, "property.0.name", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "property.0.type.0.measurement.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "property.0.unit", "Celsius"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotsitewise.ResourceAssetModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetModelConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccAssetModelConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 iotsitewise.DescribeAssetModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "property.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccAssetModelConfig_properties(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v2),
+					testAccCheckAssetModelPropertyIDUnchanged(&v1, &v2, "temperature"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Wind turbine"),
+					resource.TestCheckResourceAttr(resourceName, "property.#", acctest.Ct4),
+					resource.TestCheckResourceAttr(resourceName, "property.1.name", "location"),
+					resource.TestCheckResourceAttr(resourceName, "property.1.type.0.attribute.0.default_value", "Renton"),
+					resource.TestCheckResourceAttr(resourceName, "property.2.name", "temperature_fahrenheit"),
+					resource.TestCheckResourceAttr(resourceName, "property.2.type.0.transform.0.variable.0.value.0.property_id", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "property.3.name", "average_temperature"),
+					resource.TestCheckResourceAttr(resourceName, "property.3.type.0.metric.0.window.0.tumbling.0.interval", "5m"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_hierarchy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+	childResourceName := "aws_iotsitewise_asset_model.child"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_hierarchy(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "hierarchy.0.child_asset_model_id", childResourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "hierarchy.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.0.name", "turbines"),
+					resource.TestCheckResourceAttr(resourceName, "property.0.type.0.metric.0.variable.0.value.0.hierarchy_id", "turbines"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssetModelExists(ctx context.Context, n string, v *iotsitewise.DescribeAssetModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		output, err := tfiotsitewise.FindAssetModelByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssetModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotsitewise_asset_model" {
+				continue
+			}
+
+			_, err := tfiotsitewise.FindAssetModelByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT SiteWise Asset Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAssetModelPropertyIDUnchanged(before, after *iotsitewise.DescribeAssetModelOutput, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		find := func(v *iotsitewise.DescribeAssetModelOutput) string {
+			for _, p := range v.AssetModelProperties {
+				if aws.StringValue(p.Name) == name {
+					return aws.StringValue(p.Id)
+				}
+			}
+			return ""
+		}
+
+		if b, a := find(before), find(after); b == "" || b != a {
+			return fmt.Errorf("IoT SiteWise Asset Model property %q ID changed (%s => %s)", name, b, a)
+		}
+
+		return nil
+	}
+}
+
+func testAccAssetModelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  property {
+    name      = "temperature"
+    data_type = "DOUBLE"
+    unit      = "Celsius"
+
+    type {
+      measurement {}
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAssetModelConfig_properties(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name        = %[1]q
+  description = "Wind turbine"
+
+  property {
+    name      = "temperature"
+    data_type = "DOUBLE"
+    unit      = "Celsius"
+
+    type {
+      measurement {}
+    }
+  }
+
+  property {
+    name      = "location"
+    data_type = "STRING"
+
+    type {
+      attribute {
+        default_value = "Renton"
+      }
+    }
+  }
+
+  property {
+    name      = "temperature_fahrenheit"
+    data_type = "DOUBLE"
+    unit      = "Fahrenheit"
+
+    type {
+      transform {
+        expression = "temp_c * 9 / 5 + 32"
+
+        variable {
+          name = "temp_c"
+
+          value {
+            property_id = "temperature"
+          }
+        }
+      }
+    }
+  }
+
+  property {
+    name      = "average_temperature"
+    data_type = "DOUBLE"
+    unit      = "Celsius"
+
+    type {
+      metric {
+        expression = "avg(temp_c)"
+
+        variable {
+          name = "temp_c"
+
+          value {
+            property_id = "temperature"
+          }
+        }
+
+        window {
+          tumbling {
+            interval = "5m"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAssetModelConfig_hierarchy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "child" {
+  name = "%[1]s-child"
+
+  property {
+    name      = "power"
+    data_type = "DOUBLE"
+
+    type {
+      measurement {}
+    }
+  }
+}
+
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  hierarchy {
+    name                 = "turbines"
+    child_asset_model_id = aws_iotsitewise_asset_model.child.id
+  }
+
+  property {
+    name      = "total_power"
+    data_type = "DOUBLE"
+
+    type {
+      metric {
+        expression = "sum(power)"
+
+        variable {
+          name = "power"
+
+          value {
+            hierarchy_id = "turbines"
+            property_id  = aws_iotsitewise_asset_model.child.property[0].id
+          }
+        }
+
+        window {
+          tumbling {
+            interval = "1h"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAssetModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  property {
+    name      = "temperature"
+    data_type = "DOUBLE"
+
+    type {
+      measurement {}
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAssetModelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  property {
+    name      = "temperature"
+    data_type = "DOUBLE"
+
+    type {
+      measurement {}
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
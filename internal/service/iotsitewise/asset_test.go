@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTSiteWiseAsset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iotsitewise", regexache.MustCompile(`asset/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "asset_model_id", "aws_iotsitewise_asset_model.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_association.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "property.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotsitewise.ResourceAsset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccAssetConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_propertyAlias(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_propertyAlias(rName, "/plant/turbine-1/temperature"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "property.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property.*", map[string]string{
+						names.AttrAlias:      "/plant/turbine-1/temperature",
+						"notification_state": "DISABLED",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetConfig_propertyAlias(rName, "/plant/turbine-2/temperature"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "property.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property.*", map[string]string{
+						names.AttrAlias: "/plant/turbine-2/temperature",
+					}),
+				),
+			},
+			{
+				Config: testAccAssetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "property.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_hierarchyAssociation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_hierarchyAssociation(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_association.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetConfig_hierarchyAssociation(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_association.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAssetExists(ctx context.Context, n string, v *iotsitewise.DescribeAssetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		output, err := tfiotsitewise.FindAssetByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotsitewise_asset" {
+				continue
+			}
+
+			_, err := tfiotsitewise.FindAssetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT SiteWise Asset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAssetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  property {
+    name      = "temperature"
+    data_type = "DOUBLE"
+
+    type {
+      measurement {}
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAssetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+}
+`, rName))
+}
+
+func testAccAssetConfig_propertyAlias(rName, alias string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+
+  property {
+    id    = aws_iotsitewise_asset_model.test.property[0].id
+    alias = %[2]q
+  }
+}
+`, rName, alias))
+}
+
+func testAccAssetConfig_hierarchyAssociation(rName string, childCount int) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "parent" {
+  name = "%[1]s-parent"
+
+  hierarchy {
+    name                 = "turbines"
+    child_asset_model_id = aws_iotsitewise_asset_model.test.id
+  }
+}
+
+resource "aws_iotsitewise_asset" "child" {
+  count = %[2]d
+
+  name           = "%[1]s-child-${count.index}"
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+}
+
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.parent.id
+
+  dynamic "hierarchy_association" {
+    for_each = aws_iotsitewise_asset.child
+
+    content {
+      hierarchy_id   = aws_iotsitewise_asset_model.parent.hierarchy[0].id
+      child_asset_id = hierarchy_association.value.id
+    }
+  }
+}
+`, rName, childCount))
+}
+
+func testAccAssetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAssetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
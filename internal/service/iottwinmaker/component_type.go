@@ -0,0 +1,590 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iottwinmaker_component_type", name="Component Type")
+// @Tags(identifierAttribute="arn")
+func ResourceComponentType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentTypeCreate,
+		ReadWithoutTimeout:   resourceComponentTypeRead,
+		UpdateWithoutTimeout: resourceComponentTypeUpdate,
+		DeleteWithoutTimeout: resourceComponentTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.:-]+$`), "must contain only alphanumeric characters, periods, colons, underscores and hyphens"),
+				),
+			},
+			"component_type_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"extends_from": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"functions": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validJSONMap[iottwinmaker.FunctionRequest](),
+				DiffSuppressFunc: suppressEquivalentJSONMapDiffs[iottwinmaker.FunctionRequest](normalizeFunction),
+				StateFunc: func(v interface{}) string {
+					json, _ := jsonMapCanonicalJSON[iottwinmaker.FunctionRequest](v.(string), normalizeFunction)
+					return json
+				},
+			},
+			"is_abstract": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_schema_initialized": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_singleton": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"property_definitions": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validJSONMap[iottwinmaker.PropertyDefinitionRequest](),
+				DiffSuppressFunc: suppressEquivalentJSONMapDiffs[iottwinmaker.PropertyDefinitionRequest](normalizePropertyDefinition),
+				StateFunc: func(v interface{}) string {
+					json, _ := jsonMapCanonicalJSON[iottwinmaker.PropertyDefinitionRequest](v.(string), normalizePropertyDefinition)
+					return json
+				},
+			},
+			"property_groups": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validJSONMap[iottwinmaker.PropertyGroupRequest](),
+				DiffSuppressFunc: suppressEquivalentJSONMapDiffs[iottwinmaker.PropertyGroupRequest](nil),
+				StateFunc: func(v interface{}) string {
+					json, _ := jsonMapCanonicalJSON[iottwinmaker.PropertyGroupRequest](v.(string), nil)
+					return json
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	componentTypeResourceIDPartCount = 2
+)
+
+func resourceComponentTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	workspaceID, componentTypeID := d.Get("workspace_id").(string), d.Get("component_type_id").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{workspaceID, componentTypeID}, componentTypeResourceIDPartCount, false))
+	input := &iottwinmaker.CreateComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		Tags:            getTagsIn(ctx),
+		WorkspaceId:     aws.String(workspaceID),
+	}
+
+	if v, ok := d.GetOk("component_type_name"); ok {
+		input.ComponentTypeName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("extends_from"); ok && len(v.([]interface{})) > 0 {
+		input.ExtendsFrom = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("functions"); ok {
+		functions, err := expandJSONMap[iottwinmaker.FunctionRequest](v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT TwinMaker Component Type (%s): functions: %s", id, err)
+		}
+
+		input.Functions = functions
+	}
+
+	if v, ok := d.GetOk("is_singleton"); ok {
+		input.IsSingleton = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("property_definitions"); ok {
+		propertyDefinitions, err := expandJSONMap[iottwinmaker.PropertyDefinitionRequest](v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT TwinMaker Component Type (%s): property_definitions: %s", id, err)
+		}
+
+		input.PropertyDefinitions = propertyDefinitions
+	}
+
+	if v, ok := d.GetOk("property_groups"); ok {
+		propertyGroups, err := expandJSONMap[iottwinmaker.PropertyGroupRequest](v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT TwinMaker Component Type (%s): property_groups: %s", id, err)
+		}
+
+		input.PropertyGroups = propertyGroups
+	}
+
+	_, err := conn.CreateComponentTypeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT TwinMaker Component Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitComponentTypeActive(ctx, conn, workspaceID, componentTypeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Component Type (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceComponentTypeRead(ctx, d, meta)...)
+}
+
+func resourceComponentTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), componentTypeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	workspaceID, componentTypeID := parts[0], parts[1]
+	output, err := FindComponentTypeByTwoPartKey(ctx, conn, workspaceID, componentTypeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT TwinMaker Component Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set("component_type_id", output.ComponentTypeId)
+	d.Set("component_type_name", output.ComponentTypeName)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("extends_from", aws.StringValueSlice(output.ExtendsFrom))
+	functions, err := flattenJSONMap(flattenFunctions(output.Functions), normalizeFunction)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+	d.Set("functions", functions)
+	d.Set("is_abstract", output.IsAbstract)
+	d.Set("is_schema_initialized", output.IsSchemaInitialized)
+	d.Set("is_singleton", output.IsSingleton)
+	propertyDefinitions, err := flattenJSONMap(flattenPropertyDefinitions(output.PropertyDefinitions), normalizePropertyDefinition)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+	d.Set("property_definitions", propertyDefinitions)
+	propertyGroups, err := flattenJSONMap(flattenPropertyGroups(output.PropertyGroups), nil)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+	d.Set("property_groups", propertyGroups)
+	d.Set("workspace_id", output.WorkspaceId)
+
+	return diags
+}
+
+func resourceComponentTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		parts, err := flex.ExpandResourceId(d.Id(), componentTypeResourceIDPartCount, false)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		workspaceID, componentTypeID := parts[0], parts[1]
+		input := &iottwinmaker.UpdateComponentTypeInput{
+			ComponentTypeId: aws.String(componentTypeID),
+			Description:     aws.String(d.Get(names.AttrDescription).(string)),
+			ExtendsFrom:     flex.ExpandStringList(d.Get("extends_from").([]interface{})),
+			IsSingleton:     aws.Bool(d.Get("is_singleton").(bool)),
+			WorkspaceId:     aws.String(workspaceID),
+		}
+
+		if v, ok := d.GetOk("component_type_name"); ok {
+			input.ComponentTypeName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("functions"); ok {
+			functions, err := expandJSONMap[iottwinmaker.FunctionRequest](v.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Component Type (%s): functions: %s", d.Id(), err)
+			}
+
+			input.Functions = functions
+		}
+
+		if v, ok := d.GetOk("property_definitions"); ok {
+			propertyDefinitions, err := expandJSONMap[iottwinmaker.PropertyDefinitionRequest](v.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Component Type (%s): property_definitions: %s", d.Id(), err)
+			}
+
+			input.PropertyDefinitions = propertyDefinitions
+		}
+
+		if v, ok := d.GetOk("property_groups"); ok {
+			propertyGroups, err := expandJSONMap[iottwinmaker.PropertyGroupRequest](v.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Component Type (%s): property_groups: %s", d.Id(), err)
+			}
+
+			input.PropertyGroups = propertyGroups
+		}
+
+		_, err = conn.UpdateComponentTypeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitComponentTypeActive(ctx, conn, workspaceID, componentTypeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Component Type (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceComponentTypeRead(ctx, d, meta)...)
+}
+
+func resourceComponentTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), componentTypeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	workspaceID, componentTypeID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting IoT TwinMaker Component Type: %s", d.Id())
+	_, err = conn.DeleteComponentTypeWithContext(ctx, &iottwinmaker.DeleteComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		WorkspaceId:     aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitComponentTypeDeleted(ctx, conn, workspaceID, componentTypeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Component Type (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindComponentTypeByTwoPartKey(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string) (*iottwinmaker.GetComponentTypeOutput, error) {
+	input := &iottwinmaker.GetComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		WorkspaceId:     aws.String(workspaceID),
+	}
+
+	output, err := conn.GetComponentTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusComponentType(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindComponentTypeByTwoPartKey(ctx, conn, workspaceID, componentTypeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.State), nil
+	}
+}
+
+func waitComponentTypeActive(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string, timeout time.Duration) (*iottwinmaker.GetComponentTypeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iottwinmaker.StateCreating, iottwinmaker.StateUpdating},
+		Target:  []string{iottwinmaker.StateActive},
+		Refresh: statusComponentType(ctx, conn, workspaceID, componentTypeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetComponentTypeOutput); ok {
+		if v := output.Status.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitComponentTypeDeleted(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string, timeout time.Duration) (*iottwinmaker.GetComponentTypeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iottwinmaker.StateActive, iottwinmaker.StateDeleting},
+		Target:  []string{},
+		Refresh: statusComponentType(ctx, conn, workspaceID, componentTypeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetComponentTypeOutput); ok {
+		if v := output.Status.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// flattenFunctions omits inherited functions, which are owned by the
+// component type(s) listed in extends_from.
+func flattenFunctions(apiObjects map[string]*iottwinmaker.FunctionResponse) map[string]*iottwinmaker.FunctionRequest {
+	m := make(map[string]*iottwinmaker.FunctionRequest)
+
+	for k, v := range apiObjects {
+		if v == nil || aws.BoolValue(v.IsInherited) {
+			continue
+		}
+
+		m[k] = &iottwinmaker.FunctionRequest{
+			ImplementedBy:      v.ImplementedBy,
+			RequiredProperties: v.RequiredProperties,
+			Scope:              v.Scope,
+		}
+	}
+
+	return m
+}
+
+func flattenPropertyDefinitions(apiObjects map[string]*iottwinmaker.PropertyDefinitionResponse) map[string]*iottwinmaker.PropertyDefinitionRequest {
+	m := make(map[string]*iottwinmaker.PropertyDefinitionRequest)
+
+	for k, v := range apiObjects {
+		if v == nil || aws.BoolValue(v.IsInherited) {
+			continue
+		}
+
+		m[k] = &iottwinmaker.PropertyDefinitionRequest{
+			Configuration:      v.Configuration,
+			DataType:           v.DataType,
+			DefaultValue:       v.DefaultValue,
+			DisplayName:        v.DisplayName,
+			IsExternalId:       v.IsExternalId,
+			IsRequiredInEntity: v.IsRequiredInEntity,
+			IsStoredExternally: v.IsStoredExternally,
+			IsTimeSeries:       v.IsTimeSeries,
+		}
+	}
+
+	return m
+}
+
+func flattenPropertyGroups(apiObjects map[string]*iottwinmaker.PropertyGroupResponse) map[string]*iottwinmaker.PropertyGroupRequest {
+	m := make(map[string]*iottwinmaker.PropertyGroupRequest)
+
+	for k, v := range apiObjects {
+		if v == nil || aws.BoolValue(v.IsInherited) {
+			continue
+		}
+
+		m[k] = &iottwinmaker.PropertyGroupRequest{
+			GroupType:     v.GroupType,
+			PropertyNames: v.PropertyNames,
+		}
+	}
+
+	return m
+}
+
+// normalizeFunction treats unset and false flags as equivalent,
+// as the API always returns them.
+func normalizeFunction(apiObject *iottwinmaker.FunctionRequest) {
+	if v := apiObject.ImplementedBy; v != nil && !aws.BoolValue(v.IsNative) {
+		v.IsNative = nil
+	}
+}
+
+func normalizePropertyDefinition(apiObject *iottwinmaker.PropertyDefinitionRequest) {
+	if len(apiObject.Configuration) == 0 {
+		apiObject.Configuration = nil
+	}
+
+	for _, v := range []**bool{&apiObject.IsExternalId, &apiObject.IsRequiredInEntity, &apiObject.IsStoredExternally, &apiObject.IsTimeSeries} {
+		if !aws.BoolValue(*v) {
+			*v = nil
+		}
+	}
+}
+
+func validJSONMap[T any]() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		if _, err := expandJSONMap[T](v.(string)); err != nil {
+			errors = append(errors, fmt.Errorf("%q is invalid: %s", k, err))
+		}
+
+		return
+	}
+}
+
+// suppressEquivalentJSONMapDiffs compares the canonical form of both values
+// so that key ordering and whitespace don't produce a diff.
+func suppressEquivalentJSONMapDiffs[T any](normalize func(*T)) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		oldJSON, err := jsonMapCanonicalJSON(old, normalize)
+		if err != nil {
+			return false
+		}
+
+		newJSON, err := jsonMapCanonicalJSON(new, normalize)
+		if err != nil {
+			return false
+		}
+
+		return oldJSON == newJSON
+	}
+}
+
+func jsonMapCanonicalJSON[T any](v string, normalize func(*T)) (string, error) {
+	if v == "" {
+		return v, nil
+	}
+
+	apiObjects, err := expandJSONMap[T](v)
+	if err != nil {
+		return v, err
+	}
+
+	return flattenJSONMap(apiObjects, normalize)
+}
+
+func expandJSONMap[T any](v string) (map[string]*T, error) {
+	var apiObjects map[string]*T
+
+	if err := json.Unmarshal([]byte(v), &apiObjects); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %s", err)
+	}
+
+	return apiObjects, nil
+}
+
+func flattenJSONMap[T any](apiObjects map[string]*T, normalize func(*T)) (string, error) {
+	if len(apiObjects) == 0 {
+		return "", nil
+	}
+
+	if normalize != nil {
+		for _, v := range apiObjects {
+			if v != nil {
+				normalize(v)
+			}
+		}
+	}
+
+	b, err := jsonutil.BuildJSON(apiObjects)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
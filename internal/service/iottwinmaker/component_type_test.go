@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTTwinMakerComponentType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetComponentTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_component_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "component_type_id", "com.example.terraform.test"),
+					resource.TestCheckResourceAttr(resourceName, "is_abstract", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "is_singleton", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "property_definitions", `{"temperature":{"dataType":{"type":"DOUBLE"},"isTimeSeries":true}}`),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "aws_iottwinmaker_workspace.test", "workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComponentTypeConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "property_definitions", `{"humidity":{"dataType":{"type":"DOUBLE"},"isTimeSeries":true},"temperature":{"dataType":{"type":"DOUBLE"},"isTimeSeries":true}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerComponentType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetComponentTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_component_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiottwinmaker.ResourceComponentType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComponentTypeExists(ctx context.Context, n string, v *iottwinmaker.GetComponentTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+		output, err := tfiottwinmaker.FindComponentTypeByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["component_type_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckComponentTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iottwinmaker_component_type" {
+				continue
+			}
+
+			_, err := tfiottwinmaker.FindComponentTypeByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["component_type_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT TwinMaker Component Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccComponentTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), `
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = "com.example.terraform.test"
+
+  property_definitions = jsonencode({
+    temperature = {
+      dataType = {
+        type = "DOUBLE"
+      }
+      isTimeSeries = true
+    }
+  })
+}
+`)
+}
+
+func testAccComponentTypeConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), `
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = "com.example.terraform.test"
+  description       = "Updated"
+
+  property_definitions = jsonencode({
+    temperature = {
+      dataType = {
+        type = "DOUBLE"
+      }
+      isTimeSeries = true
+    }
+    humidity = {
+      dataType = {
+        type = "DOUBLE"
+      }
+      isTimeSeries       = true
+      isRequiredInEntity = false
+    }
+  })
+}
+`)
+}
@@ -0,0 +1,581 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iottwinmaker_entity", name="Entity")
+// @Tags(identifierAttribute="arn")
+func ResourceEntity() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEntityCreate,
+		ReadWithoutTimeout:   resourceEntityRead,
+		UpdateWithoutTimeout: resourceEntityUpdate,
+		DeleteWithoutTimeout: resourceEntityDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_type_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 2048),
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						names.AttrProperties: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validJSONMap[iottwinmaker.DataValue](),
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"entity_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"entity_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"has_child_entities": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"parent_entity_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	entityResourceIDPartCount = 2
+)
+
+func resourceEntityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	workspaceID, name := d.Get("workspace_id").(string), d.Get("entity_name").(string)
+	input := &iottwinmaker.CreateEntityInput{
+		EntityName:  aws.String(name),
+		Tags:        getTagsIn(ctx),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	if v, ok := d.GetOk("component"); ok && v.(*schema.Set).Len() > 0 {
+		components, err := expandComponentRequests(v.(*schema.Set).List())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT TwinMaker Entity (%s): %s", name, err)
+		}
+
+		input.Components = components
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entity_id"); ok {
+		input.EntityId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_entity_id"); ok {
+		input.ParentEntityId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateEntityWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT TwinMaker Entity (%s): %s", name, err)
+	}
+
+	entityID := aws.StringValue(output.EntityId)
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{workspaceID, entityID}, entityResourceIDPartCount, false)))
+
+	if _, err := waitEntityActive(ctx, conn, workspaceID, entityID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Entity (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceEntityRead(ctx, d, meta)...)
+}
+
+func resourceEntityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), entityResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	workspaceID, entityID := parts[0], parts[1]
+	output, err := FindEntityByTwoPartKey(ctx, conn, workspaceID, entityID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT TwinMaker Entity (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT TwinMaker Entity (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	components, err := flattenComponentResponses(output.Components, d.Get("component").(*schema.Set).List())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT TwinMaker Entity (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("component", components); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting component: %s", err)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("entity_id", output.EntityId)
+	d.Set("entity_name", output.EntityName)
+	d.Set("has_child_entities", output.HasChildEntities)
+	d.Set("parent_entity_id", output.ParentEntityId)
+	d.Set("workspace_id", output.WorkspaceId)
+
+	return diags
+}
+
+func resourceEntityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		parts, err := flex.ExpandResourceId(d.Id(), entityResourceIDPartCount, false)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		workspaceID, entityID := parts[0], parts[1]
+		input := &iottwinmaker.UpdateEntityInput{
+			EntityId:    aws.String(entityID),
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("entity_name") {
+			input.EntityName = aws.String(d.Get("entity_name").(string))
+		}
+
+		if d.HasChange("parent_entity_id") {
+			input.ParentEntityUpdate = &iottwinmaker.ParentEntityUpdateRequest{
+				ParentEntityId: aws.String(d.Get("parent_entity_id").(string)),
+				UpdateType:     aws.String(iottwinmaker.ParentEntityUpdateTypeUpdate),
+			}
+		}
+
+		// A component can't change type in place, so it's removed by the first
+		// update and then recreated by a second one.
+		var recreates map[string]*iottwinmaker.ComponentUpdateRequest
+
+		if d.HasChange("component") {
+			o, n := d.GetChange("component")
+			updates, creates, err := expandComponentUpdateRequests(o.(*schema.Set).List(), n.(*schema.Set).List())
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Entity (%s): %s", d.Id(), err)
+			}
+
+			input.ComponentUpdates, recreates = updates, creates
+		}
+
+		if err := updateEntity(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Entity (%s): %s", d.Id(), err)
+		}
+
+		if len(recreates) > 0 {
+			input := &iottwinmaker.UpdateEntityInput{
+				ComponentUpdates: recreates,
+				EntityId:         aws.String(entityID),
+				WorkspaceId:      aws.String(workspaceID),
+			}
+
+			if err := updateEntity(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Entity (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceEntityRead(ctx, d, meta)...)
+}
+
+func resourceEntityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), entityResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	workspaceID, entityID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting IoT TwinMaker Entity: %s", d.Id())
+	_, err = conn.DeleteEntityWithContext(ctx, &iottwinmaker.DeleteEntityInput{
+		EntityId:    aws.String(entityID),
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT TwinMaker Entity (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEntityDeleted(ctx, conn, workspaceID, entityID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Entity (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateEntity(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, input *iottwinmaker.UpdateEntityInput, timeout time.Duration) error {
+	_, err := conn.UpdateEntityWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitEntityActive(ctx, conn, aws.StringValue(input.WorkspaceId), aws.StringValue(input.EntityId), timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
+
+func FindEntityByTwoPartKey(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, entityID string) (*iottwinmaker.GetEntityOutput, error) {
+	input := &iottwinmaker.GetEntityInput{
+		EntityId:    aws.String(entityID),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	output, err := conn.GetEntityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEntity(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, entityID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEntityByTwoPartKey(ctx, conn, workspaceID, entityID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.State), nil
+	}
+}
+
+func waitEntityActive(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, entityID string, timeout time.Duration) (*iottwinmaker.GetEntityOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iottwinmaker.StateCreating, iottwinmaker.StateUpdating},
+		Target:  []string{iottwinmaker.StateActive},
+		Refresh: statusEntity(ctx, conn, workspaceID, entityID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetEntityOutput); ok {
+		if v := output.Status.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEntityDeleted(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, entityID string, timeout time.Duration) (*iottwinmaker.GetEntityOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iottwinmaker.StateActive, iottwinmaker.StateDeleting},
+		Target:  []string{},
+		Refresh: statusEntity(ctx, conn, workspaceID, entityID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetEntityOutput); ok {
+		if v := output.Status.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandComponentRequests(tfList []interface{}) (map[string]*iottwinmaker.ComponentRequest, error) {
+	apiObjects := make(map[string]*iottwinmaker.ComponentRequest)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iottwinmaker.ComponentRequest{
+			ComponentTypeId: aws.String(tfMap["component_type_id"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrProperties].(string); ok && v != "" {
+			values, err := expandJSONMap[iottwinmaker.DataValue](v)
+			if err != nil {
+				return nil, fmt.Errorf("component (%s) properties: %w", tfMap[names.AttrName].(string), err)
+			}
+
+			apiObject.Properties = make(map[string]*iottwinmaker.PropertyRequest)
+			for k, v := range values {
+				apiObject.Properties[k] = &iottwinmaker.PropertyRequest{
+					Value: v,
+				}
+			}
+		}
+
+		apiObjects[tfMap[names.AttrName].(string)] = apiObject
+	}
+
+	return apiObjects, nil
+}
+
+// expandComponentUpdateRequests returns the component updates to apply and,
+// separately, the components that must be created once those updates have
+// deleted a previous component of the same name but a different type.
+func expandComponentUpdateRequests(oldTFList, newTFList []interface{}) (map[string]*iottwinmaker.ComponentUpdateRequest, map[string]*iottwinmaker.ComponentUpdateRequest, error) {
+	oldComponents, err := expandComponentRequests(oldTFList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newComponents, err := expandComponentRequests(newTFList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updates := make(map[string]*iottwinmaker.ComponentUpdateRequest)
+	creates := make(map[string]*iottwinmaker.ComponentUpdateRequest)
+
+	for name := range oldComponents {
+		if _, ok := newComponents[name]; !ok {
+			updates[name] = &iottwinmaker.ComponentUpdateRequest{
+				UpdateType: aws.String(iottwinmaker.ComponentUpdateTypeDelete),
+			}
+		}
+	}
+
+	for name, newComponent := range newComponents {
+		create := &iottwinmaker.ComponentUpdateRequest{
+			ComponentTypeId: newComponent.ComponentTypeId,
+			Description:     newComponent.Description,
+			PropertyUpdates: newComponent.Properties,
+			UpdateType:      aws.String(iottwinmaker.ComponentUpdateTypeCreate),
+		}
+
+		oldComponent, ok := oldComponents[name]
+
+		if !ok {
+			updates[name] = create
+			continue
+		}
+
+		if aws.StringValue(oldComponent.ComponentTypeId) != aws.StringValue(newComponent.ComponentTypeId) {
+			updates[name] = &iottwinmaker.ComponentUpdateRequest{
+				UpdateType: aws.String(iottwinmaker.ComponentUpdateTypeDelete),
+			}
+			creates[name] = create
+			continue
+		}
+
+		propertyUpdates := make(map[string]*iottwinmaker.PropertyRequest)
+
+		for k, v := range newComponent.Properties {
+			propertyUpdates[k] = &iottwinmaker.PropertyRequest{
+				UpdateType: aws.String(iottwinmaker.PropertyUpdateTypeUpdate),
+				Value:      v.Value,
+			}
+		}
+
+		for k := range oldComponent.Properties {
+			if _, ok := newComponent.Properties[k]; !ok {
+				propertyUpdates[k] = &iottwinmaker.PropertyRequest{
+					UpdateType: aws.String(iottwinmaker.PropertyUpdateTypeResetValue),
+				}
+			}
+		}
+
+		updates[name] = &iottwinmaker.ComponentUpdateRequest{
+			Description:     aws.String(aws.StringValue(newComponent.Description)),
+			PropertyUpdates: propertyUpdates,
+			UpdateType:      aws.String(iottwinmaker.ComponentUpdateTypeUpdate),
+		}
+	}
+
+	return updates, creates, nil
+}
+
+// flattenComponentResponses only reports the property values that are already
+// tracked in state for a component, as the API also returns every property
+// inherited from the component type. Imported components report all set values.
+func flattenComponentResponses(apiObjects map[string]*iottwinmaker.ComponentResponse, priorTFList []interface{}) ([]interface{}, error) {
+	priorProperties := make(map[string]map[string]*iottwinmaker.DataValue)
+
+	for _, tfMapRaw := range priorTFList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		values := make(map[string]*iottwinmaker.DataValue)
+		if v, ok := tfMap[names.AttrProperties].(string); ok && v != "" {
+			if v, err := expandJSONMap[iottwinmaker.DataValue](v); err == nil {
+				values = v
+			}
+		}
+
+		priorProperties[tfMap[names.AttrName].(string)] = values
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"component_type_id":   aws.StringValue(apiObject.ComponentTypeId),
+			names.AttrDescription: aws.StringValue(apiObject.Description),
+			names.AttrName:        name,
+		}
+
+		prior, tracked := priorProperties[name]
+		values := make(map[string]*iottwinmaker.DataValue)
+
+		for k, v := range apiObject.Properties {
+			if v == nil || v.Value == nil {
+				continue
+			}
+
+			if _, ok := prior[k]; tracked && !ok {
+				continue
+			}
+
+			values[k] = v.Value
+		}
+
+		properties, err := flattenJSONMap[iottwinmaker.DataValue](values, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		tfMap[names.AttrProperties] = properties
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTTwinMakerEntity_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetEntityOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_entity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "component.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "entity_id"),
+					resource.TestCheckResourceAttr(resourceName, "entity_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "has_child_entities", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "parent_entity_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "aws_iottwinmaker_workspace.test", "workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerEntity_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetEntityOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_entity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiottwinmaker.ResourceEntity(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerEntity_component(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetEntityOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_entity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityConfig_component(rName, "Line 1", "ok"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "component.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"component_type_id":   "com.example.terraform.test",
+						names.AttrDescription: "Line 1",
+						names.AttrName:        "status",
+						names.AttrProperties:  `{"state":{"stringValue":"ok"}}`,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEntityConfig_component(rName, "Line 2", "degraded"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "component.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						names.AttrDescription: "Line 2",
+						names.AttrProperties:  `{"state":{"stringValue":"degraded"}}`,
+					}),
+				),
+			},
+			{
+				Config: testAccEntityConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "component.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerEntity_parentEntityID(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetEntityOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_entity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityConfig_parentEntityID(rName, "parent1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_entity_id", "aws_iottwinmaker_entity.parent1", "entity_id"),
+				),
+			},
+			{
+				Config: testAccEntityConfig_parentEntityID(rName, "parent2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_entity_id", "aws_iottwinmaker_entity.parent2", "entity_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEntityExists(ctx context.Context, n string, v *iottwinmaker.GetEntityOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+		output, err := tfiottwinmaker.FindEntityByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["entity_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEntityDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iottwinmaker_entity" {
+				continue
+			}
+
+			_, err := tfiottwinmaker.FindEntityByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["entity_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT TwinMaker Entity %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEntityConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_entity" "test" {
+  workspace_id = aws_iottwinmaker_workspace.test.workspace_id
+  entity_name  = %[1]q
+}
+`, rName))
+}
+
+func testAccEntityConfig_component(rName, description, state string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = "com.example.terraform.test"
+
+  property_definitions = jsonencode({
+    state = {
+      dataType = {
+        type = "STRING"
+      }
+    }
+  })
+}
+
+resource "aws_iottwinmaker_entity" "test" {
+  workspace_id = aws_iottwinmaker_workspace.test.workspace_id
+  entity_name  = %[1]q
+
+  component {
+    name              = "status"
+    component_type_id = aws_iottwinmaker_component_type.test.component_type_id
+    description       = %[2]q
+
+    properties = jsonencode({
+      state = {
+        stringValue = %[3]q
+      }
+    })
+  }
+}
+`, rName, description, state))
+}
+
+func testAccEntityConfig_parentEntityID(rName, parent string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_entity" "parent1" {
+  workspace_id = aws_iottwinmaker_workspace.test.workspace_id
+  entity_name  = "%[1]s-parent1"
+}
+
+resource "aws_iottwinmaker_entity" "parent2" {
+  workspace_id = aws_iottwinmaker_workspace.test.workspace_id
+  entity_name  = "%[1]s-parent2"
+}
+
+resource "aws_iottwinmaker_entity" "test" {
+  workspace_id     = aws_iottwinmaker_workspace.test.workspace_id
+  entity_name      = %[1]q
+  parent_entity_id = aws_iottwinmaker_entity.%[2]s.entity_id
+}
+`, rName, parent))
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iottwinmaker_scene", name="Scene")
+// @Tags(identifierAttribute="arn")
+func ResourceScene() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSceneCreate,
+		ReadWithoutTimeout:   resourceSceneRead,
+		UpdateWithoutTimeout: resourceSceneUpdate,
+		DeleteWithoutTimeout: resourceSceneDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"content_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"scene_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIDFunc(),
+			},
+			"scene_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	sceneResourceIDPartCount = 2
+)
+
+func resourceSceneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	workspaceID, sceneID := d.Get("workspace_id").(string), d.Get("scene_id").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{workspaceID, sceneID}, sceneResourceIDPartCount, false))
+	input := &iottwinmaker.CreateSceneInput{
+		ContentLocation: aws.String(d.Get("content_location").(string)),
+		SceneId:         aws.String(sceneID),
+		Tags:            getTagsIn(ctx),
+		WorkspaceId:     aws.String(workspaceID),
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok && v.(*schema.Set).Len() > 0 {
+		input.Capabilities = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scene_metadata"); ok && len(v.(map[string]interface{})) > 0 {
+		input.SceneMetadata = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	_, err := conn.CreateSceneWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT TwinMaker Scene (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceSceneRead(ctx, d, meta)...)
+}
+
+func resourceSceneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), sceneResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindSceneByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT TwinMaker Scene (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT TwinMaker Scene (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set("capabilities", aws.StringValueSlice(output.Capabilities))
+	d.Set("content_location", output.ContentLocation)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("scene_id", output.SceneId)
+	d.Set("scene_metadata", aws.StringValueMap(output.SceneMetadata))
+	d.Set("workspace_id", output.WorkspaceId)
+
+	return diags
+}
+
+func resourceSceneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		parts, err := flex.ExpandResourceId(d.Id(), sceneResourceIDPartCount, false)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &iottwinmaker.UpdateSceneInput{
+			Capabilities:    flex.ExpandStringSet(d.Get("capabilities").(*schema.Set)),
+			ContentLocation: aws.String(d.Get("content_location").(string)),
+			Description:     aws.String(d.Get(names.AttrDescription).(string)),
+			SceneId:         aws.String(parts[1]),
+			SceneMetadata:   flex.ExpandStringMap(d.Get("scene_metadata").(map[string]interface{})),
+			WorkspaceId:     aws.String(parts[0]),
+		}
+
+		_, err = conn.UpdateSceneWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Scene (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSceneRead(ctx, d, meta)...)
+}
+
+func resourceSceneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), sceneResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting IoT TwinMaker Scene: %s", d.Id())
+	_, err = conn.DeleteSceneWithContext(ctx, &iottwinmaker.DeleteSceneInput{
+		SceneId:     aws.String(parts[1]),
+		WorkspaceId: aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT TwinMaker Scene (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindSceneByTwoPartKey(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, sceneID string) (*iottwinmaker.GetSceneOutput, error) {
+	input := &iottwinmaker.GetSceneInput{
+		SceneId:     aws.String(sceneID),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	output, err := conn.GetSceneWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
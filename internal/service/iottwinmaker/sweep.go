@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_iottwinmaker_component_type", &resource.Sweeper{
+		Name: "aws_iottwinmaker_component_type",
+		F:    sweepComponentTypes,
+		Dependencies: []string{
+			"aws_iottwinmaker_entity",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_entity", &resource.Sweeper{
+		Name: "aws_iottwinmaker_entity",
+		F:    sweepEntities,
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_scene", &resource.Sweeper{
+		Name: "aws_iottwinmaker_scene",
+		F:    sweepScenes,
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_workspace", &resource.Sweeper{
+		Name: "aws_iottwinmaker_workspace",
+		F:    sweepWorkspaces,
+		Dependencies: []string{
+			"aws_iottwinmaker_component_type",
+			"aws_iottwinmaker_entity",
+			"aws_iottwinmaker_scene",
+		},
+	})
+}
+
+func sweepComponentTypes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTTwinMakerConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Component Type sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		input := &iottwinmaker.ListComponentTypesInput{
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		err := conn.ListComponentTypesPagesWithContext(ctx, input, func(page *iottwinmaker.ListComponentTypesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ComponentTypeSummaries {
+				componentTypeID := aws.StringValue(v.ComponentTypeId)
+
+				// Skip the component types provided by the service.
+				if strings.HasPrefix(componentTypeID, "com.amazon.") {
+					continue
+				}
+
+				r := ResourceComponentType()
+				d := r.Data(nil)
+				d.SetId(errs.Must(flex.FlattenResourceId([]string{workspaceID, componentTypeID}, componentTypeResourceIDPartCount, false)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Error listing IoT TwinMaker Component Types for Workspace (%s): %s", workspaceID, err)
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT TwinMaker Component Types (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepEntities(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTTwinMakerConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Entity sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		input := &iottwinmaker.ListEntitiesInput{
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		err := conn.ListEntitiesPagesWithContext(ctx, input, func(page *iottwinmaker.ListEntitiesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.EntitySummaries {
+				r := ResourceEntity()
+				d := r.Data(nil)
+				d.SetId(errs.Must(flex.FlattenResourceId([]string{workspaceID, aws.StringValue(v.EntityId)}, entityResourceIDPartCount, false)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Error listing IoT TwinMaker Entities for Workspace (%s): %s", workspaceID, err)
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT TwinMaker Entities (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepScenes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTTwinMakerConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Scene sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		input := &iottwinmaker.ListScenesInput{
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		err := conn.ListScenesPagesWithContext(ctx, input, func(page *iottwinmaker.ListScenesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.SceneSummaries {
+				r := ResourceScene()
+				d := r.Data(nil)
+				d.SetId(errs.Must(flex.FlattenResourceId([]string{workspaceID, aws.StringValue(v.SceneId)}, sceneResourceIDPartCount, false)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Error listing IoT TwinMaker Scenes for Workspace (%s): %s", workspaceID, err)
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT TwinMaker Scenes (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepWorkspaces(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTTwinMakerConn(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Workspace sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		r := ResourceWorkspace()
+		d := r.Data(nil)
+		d.SetId(workspaceID)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listWorkspaceIDs(ctx context.Context, conn *iottwinmaker.IoTTwinMaker) ([]string, error) {
+	var workspaceIDs []string
+
+	err := conn.ListWorkspacesPagesWithContext(ctx, &iottwinmaker.ListWorkspacesInput{}, func(page *iottwinmaker.ListWorkspacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.WorkspaceSummaries {
+			workspaceIDs = append(workspaceIDs, aws.StringValue(v.WorkspaceId))
+		}
+
+		return !lastPage
+	})
+
+	return workspaceIDs, err
+}
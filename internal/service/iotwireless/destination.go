@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotwireless
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotwireless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotwireless_destination", name="Destination")
+// @Tags(identifierAttribute="arn")
+func ResourceDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDestinationCreate,
+		ReadWithoutTimeout:   resourceDestinationRead,
+		UpdateWithoutTimeout: resourceDestinationUpdate,
+		DeleteWithoutTimeout: resourceDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"expression_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(iotwireless.ExpressionType_Values(), false),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotwireless.CreateDestinationInput{
+		Expression:     aws.String(d.Get("expression").(string)),
+		ExpressionType: aws.String(d.Get("expression_type").(string)),
+		Name:           aws.String(name),
+		RoleArn:        aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateDestinationWithContext(ctx, input)
+	}, iotwireless.ErrCodeValidationException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Wireless Destination (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*iotwireless.CreateDestinationOutput).Name))
+
+	return append(diags, resourceDestinationRead(ctx, d, meta)...)
+}
+
+func resourceDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	output, err := FindDestinationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Wireless Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Wireless Destination (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("expression", output.Expression)
+	d.Set("expression_type", output.ExpressionType)
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+
+	return diags
+}
+
+func resourceDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotwireless.UpdateDestinationInput{
+			Description:    aws.String(d.Get(names.AttrDescription).(string)),
+			Expression:     aws.String(d.Get("expression").(string)),
+			ExpressionType: aws.String(d.Get("expression_type").(string)),
+			Name:           aws.String(d.Id()),
+			RoleArn:        aws.String(d.Get(names.AttrRoleARN).(string)),
+		}
+
+		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.UpdateDestinationWithContext(ctx, input)
+		}, iotwireless.ErrCodeValidationException, "role")
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Wireless Destination (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDestinationRead(ctx, d, meta)...)
+}
+
+func resourceDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Wireless Destination: %s", d.Id())
+	_, err := conn.DeleteDestinationWithContext(ctx, &iotwireless.DeleteDestinationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotwireless.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Wireless Destination (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDestinationByName(ctx context.Context, conn *iotwireless.IoTWireless, name string) (*iotwireless.GetDestinationOutput, error) {
+	input := &iotwireless.GetDestinationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDestinationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotwireless.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
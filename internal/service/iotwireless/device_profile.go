@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotwireless
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotwireless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotwireless_device_profile", name="Device Profile")
+// @Tags(identifierAttribute="arn")
+func ResourceDeviceProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeviceProfileCreate,
+		ReadWithoutTimeout:   resourceDeviceProfileRead,
+		UpdateWithoutTimeout: resourceDeviceProfileUpdate,
+		DeleteWithoutTimeout: resourceDeviceProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lorawan": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"class_b_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+						"class_c_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+						"factory_preset_freqs_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 20,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(1000000, 16700000),
+							},
+						},
+						"mac_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 64),
+						},
+						"max_duty_cycle": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"max_eirp": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 15),
+						},
+						"ping_slot_dr": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 15),
+						},
+						"ping_slot_freq": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1000000, 16700000),
+						},
+						"ping_slot_period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(128, 4096),
+						},
+						"reg_params_revision": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 64),
+						},
+						"rf_region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(iotwireless.SupportedRfRegion_Values(), false),
+						},
+						"rx_data_rate_2": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 15),
+						},
+						"rx_delay_1": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 15),
+						},
+						"rx_dr_offset_1": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 7),
+						},
+						"rx_freq_2": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1000000, 16700000),
+						},
+						"supports_32_bit_fcnt": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"supports_class_b": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"supports_class_c": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"supports_join": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeviceProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotwireless.CreateDeviceProfileInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("lorawan"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoRaWAN = expandLoRaWANDeviceProfile(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if name != "" {
+		input.Name = aws.String(name)
+	}
+
+	output, err := conn.CreateDeviceProfileWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Wireless Device Profile (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return append(diags, resourceDeviceProfileRead(ctx, d, meta)...)
+}
+
+func resourceDeviceProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	output, err := FindDeviceProfileByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Wireless Device Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Wireless Device Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	if output.LoRaWAN != nil {
+		if err := d.Set("lorawan", []interface{}{flattenLoRaWANDeviceProfile(output.LoRaWAN)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting lorawan: %s", err)
+		}
+	} else {
+		d.Set("lorawan", nil)
+	}
+	d.Set(names.AttrName, output.Name)
+
+	return diags
+}
+
+func resourceDeviceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceDeviceProfileRead(ctx, d, meta)...)
+}
+
+func resourceDeviceProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Wireless Device Profile: %s", d.Id())
+	_, err := conn.DeleteDeviceProfileWithContext(ctx, &iotwireless.DeleteDeviceProfileInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotwireless.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Wireless Device Profile (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDeviceProfileByID(ctx context.Context, conn *iotwireless.IoTWireless, id string) (*iotwireless.GetDeviceProfileOutput, error) {
+	input := &iotwireless.GetDeviceProfileInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetDeviceProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotwireless.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandLoRaWANDeviceProfile(tfMap map[string]interface{}) *iotwireless.LoRaWANDeviceProfile {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotwireless.LoRaWANDeviceProfile{}
+
+	if v, ok := tfMap["class_b_timeout"].(int); ok && v != 0 {
+		apiObject.ClassBTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["class_c_timeout"].(int); ok && v != 0 {
+		apiObject.ClassCTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["factory_preset_freqs_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.FactoryPresetFreqsList = flex.ExpandInt64List(v)
+	}
+
+	if v, ok := tfMap["mac_version"].(string); ok && v != "" {
+		apiObject.MacVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["max_duty_cycle"].(int); ok && v != 0 {
+		apiObject.MaxDutyCycle = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_eirp"].(int); ok && v != 0 {
+		apiObject.MaxEirp = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["ping_slot_dr"].(int); ok && v != 0 {
+		apiObject.PingSlotDr = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["ping_slot_freq"].(int); ok && v != 0 {
+		apiObject.PingSlotFreq = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["ping_slot_period"].(int); ok && v != 0 {
+		apiObject.PingSlotPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["reg_params_revision"].(string); ok && v != "" {
+		apiObject.RegParamsRevision = aws.String(v)
+	}
+
+	if v, ok := tfMap["rf_region"].(string); ok && v != "" {
+		apiObject.RfRegion = aws.String(v)
+	}
+
+	if v, ok := tfMap["rx_data_rate_2"].(int); ok && v != 0 {
+		apiObject.RxDataRate2 = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["rx_delay_1"].(int); ok && v != 0 {
+		apiObject.RxDelay1 = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["rx_dr_offset_1"].(int); ok && v != 0 {
+		apiObject.RxDrOffset1 = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["rx_freq_2"].(int); ok && v != 0 {
+		apiObject.RxFreq2 = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["supports_32_bit_fcnt"].(bool); ok {
+		apiObject.Supports32BitFCnt = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["supports_class_b"].(bool); ok {
+		apiObject.SupportsClassB = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["supports_class_c"].(bool); ok {
+		apiObject.SupportsClassC = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["supports_join"].(bool); ok {
+		apiObject.SupportsJoin = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenLoRaWANDeviceProfile(apiObject *iotwireless.LoRaWANDeviceProfile) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"class_b_timeout":           aws.Int64Value(apiObject.ClassBTimeout),
+		"class_c_timeout":           aws.Int64Value(apiObject.ClassCTimeout),
+		"factory_preset_freqs_list": flex.FlattenInt64List(apiObject.FactoryPresetFreqsList),
+		"mac_version":               aws.StringValue(apiObject.MacVersion),
+		"max_duty_cycle":            aws.Int64Value(apiObject.MaxDutyCycle),
+		"max_eirp":                  aws.Int64Value(apiObject.MaxEirp),
+		"ping_slot_dr":              aws.Int64Value(apiObject.PingSlotDr),
+		"ping_slot_freq":            aws.Int64Value(apiObject.PingSlotFreq),
+		"ping_slot_period":          aws.Int64Value(apiObject.PingSlotPeriod),
+		"reg_params_revision":       aws.StringValue(apiObject.RegParamsRevision),
+		"rf_region":                 aws.StringValue(apiObject.RfRegion),
+		"rx_data_rate_2":            aws.Int64Value(apiObject.RxDataRate2),
+		"rx_delay_1":                aws.Int64Value(apiObject.RxDelay1),
+		"rx_dr_offset_1":            aws.Int64Value(apiObject.RxDrOffset1),
+		"rx_freq_2":                 aws.Int64Value(apiObject.RxFreq2),
+		"supports_32_bit_fcnt":      aws.BoolValue(apiObject.Supports32BitFCnt),
+		"supports_class_b":          aws.BoolValue(apiObject.SupportsClassB),
+		"supports_class_c":          aws.BoolValue(apiObject.SupportsClassC),
+		"supports_join":             aws.BoolValue(apiObject.SupportsJoin),
+	}
+
+	return tfMap
+}
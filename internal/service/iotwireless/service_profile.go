@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotwireless
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotwireless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotwireless_service_profile", name="Service Profile")
+// @Tags(identifierAttribute="arn")
+func ResourceServiceProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceProfileCreate,
+		ReadWithoutTimeout:   resourceServiceProfileRead,
+		UpdateWithoutTimeout: resourceServiceProfileUpdate,
+		DeleteWithoutTimeout: resourceServiceProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lorawan": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_gw_metadata": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"channel_mask": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dev_status_req_freq": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"dl_bucket_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"dl_rate": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"dl_rate_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dr_max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 15),
+						},
+						"dr_min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 15),
+						},
+						"hr_allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"min_gw_diversity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"nwk_geo_loc": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"pr_allowed": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"ra_allowed": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_dev_status_battery": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"report_dev_status_margin": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"target_per": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ul_bucket_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ul_rate": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ul_rate_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotwireless.CreateServiceProfileInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("lorawan"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoRaWAN = expandLoRaWANServiceProfile(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if name != "" {
+		input.Name = aws.String(name)
+	}
+
+	output, err := conn.CreateServiceProfileWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Wireless Service Profile (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return append(diags, resourceServiceProfileRead(ctx, d, meta)...)
+}
+
+func resourceServiceProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	output, err := FindServiceProfileByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Wireless Service Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Wireless Service Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	if output.LoRaWAN != nil {
+		if err := d.Set("lorawan", []interface{}{flattenLoRaWANGetServiceProfileInfo(output.LoRaWAN)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting lorawan: %s", err)
+		}
+	} else {
+		d.Set("lorawan", nil)
+	}
+	d.Set(names.AttrName, output.Name)
+
+	return diags
+}
+
+func resourceServiceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceServiceProfileRead(ctx, d, meta)...)
+}
+
+func resourceServiceProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Wireless Service Profile: %s", d.Id())
+	_, err := conn.DeleteServiceProfileWithContext(ctx, &iotwireless.DeleteServiceProfileInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotwireless.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Wireless Service Profile (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindServiceProfileByID(ctx context.Context, conn *iotwireless.IoTWireless, id string) (*iotwireless.GetServiceProfileOutput, error) {
+	input := &iotwireless.GetServiceProfileInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetServiceProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotwireless.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandLoRaWANServiceProfile(tfMap map[string]interface{}) *iotwireless.LoRaWANServiceProfile {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotwireless.LoRaWANServiceProfile{}
+
+	if v, ok := tfMap["add_gw_metadata"].(bool); ok {
+		apiObject.AddGwMetadata = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["dr_max"].(int); ok && v != 0 {
+		apiObject.DrMax = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["dr_min"].(int); ok && v != 0 {
+		apiObject.DrMin = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pr_allowed"].(bool); ok {
+		apiObject.PrAllowed = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ra_allowed"].(bool); ok {
+		apiObject.RaAllowed = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenLoRaWANGetServiceProfileInfo(apiObject *iotwireless.LoRaWANGetServiceProfileInfo) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"add_gw_metadata":           aws.BoolValue(apiObject.AddGwMetadata),
+		"channel_mask":              aws.StringValue(apiObject.ChannelMask),
+		"dev_status_req_freq":       aws.Int64Value(apiObject.DevStatusReqFreq),
+		"dl_bucket_size":            aws.Int64Value(apiObject.DlBucketSize),
+		"dl_rate":                   aws.Int64Value(apiObject.DlRate),
+		"dl_rate_policy":            aws.StringValue(apiObject.DlRatePolicy),
+		"dr_max":                    aws.Int64Value(apiObject.DrMax),
+		"dr_min":                    aws.Int64Value(apiObject.DrMin),
+		"hr_allowed":                aws.BoolValue(apiObject.HrAllowed),
+		"min_gw_diversity":          aws.Int64Value(apiObject.MinGwDiversity),
+		"nwk_geo_loc":               aws.BoolValue(apiObject.NwkGeoLoc),
+		"pr_allowed":                aws.BoolValue(apiObject.PrAllowed),
+		"ra_allowed":                aws.BoolValue(apiObject.RaAllowed),
+		"report_dev_status_battery": aws.BoolValue(apiObject.ReportDevStatusBattery),
+		"report_dev_status_margin":  aws.BoolValue(apiObject.ReportDevStatusMargin),
+		"target_per":                aws.Int64Value(apiObject.TargetPer),
+		"ul_bucket_size":            aws.Int64Value(apiObject.UlBucketSize),
+		"ul_rate":                   aws.Int64Value(apiObject.UlRate),
+		"ul_rate_policy":            aws.StringValue(apiObject.UlRatePolicy),
+	}
+
+	return tfMap
+}
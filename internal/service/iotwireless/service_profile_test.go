@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotwireless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/iotwireless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotwireless "github.com/hashicorp/terraform-provider-aws/internal/service/iotwireless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTWirelessServiceProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotwireless.GetServiceProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotwireless_service_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTWirelessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceProfileConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceProfileExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iotwireless", regexache.MustCompile(`ServiceProfile/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.add_gw_metadata", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.pr_allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.ra_allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTWirelessServiceProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotwireless.GetServiceProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotwireless_service_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTWirelessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceProfileConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceProfileExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotwireless.ResourceServiceProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceProfileExists(ctx context.Context, n string, v *iotwireless.GetServiceProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTWirelessConn(ctx)
+
+		output, err := tfiotwireless.FindServiceProfileByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckServiceProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTWirelessConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotwireless_service_profile" {
+				continue
+			}
+
+			_, err := tfiotwireless.FindServiceProfileByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Wireless Service Profile %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccServiceProfileConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotwireless_service_profile" "test" {
+  name = %[1]q
+
+  lorawan {
+    add_gw_metadata = true
+  }
+}
+`, rName)
+}
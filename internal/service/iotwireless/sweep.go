@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotwireless
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotwireless"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_iotwireless_destination", &resource.Sweeper{
+		Name: "aws_iotwireless_destination",
+		F:    sweepDestinations,
+	})
+
+	resource.AddTestSweepers("aws_iotwireless_device_profile", &resource.Sweeper{
+		Name: "aws_iotwireless_device_profile",
+		F:    sweepDeviceProfiles,
+	})
+
+	resource.AddTestSweepers("aws_iotwireless_service_profile", &resource.Sweeper{
+		Name: "aws_iotwireless_service_profile",
+		F:    sweepServiceProfiles,
+	})
+
+	resource.AddTestSweepers("aws_iotwireless_wireless_gateway", &resource.Sweeper{
+		Name: "aws_iotwireless_wireless_gateway",
+		F:    sweepWirelessGateways,
+	})
+}
+
+func sweepDestinations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTWirelessConn(ctx)
+	input := &iotwireless.ListDestinationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDestinationsPagesWithContext(ctx, input, func(page *iotwireless.ListDestinationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DestinationList {
+			r := ResourceDestination()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Wireless Destination sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Wireless Destinations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Wireless Destinations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeviceProfiles(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTWirelessConn(ctx)
+	input := &iotwireless.ListDeviceProfilesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDeviceProfilesPagesWithContext(ctx, input, func(page *iotwireless.ListDeviceProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DeviceProfileList {
+			r := ResourceDeviceProfile()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Wireless Device Profile sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Wireless Device Profiles (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Wireless Device Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepServiceProfiles(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTWirelessConn(ctx)
+	input := &iotwireless.ListServiceProfilesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListServiceProfilesPagesWithContext(ctx, input, func(page *iotwireless.ListServiceProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ServiceProfileList {
+			r := ResourceServiceProfile()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Wireless Service Profile sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Wireless Service Profiles (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Wireless Service Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepWirelessGateways(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTWirelessConn(ctx)
+	input := &iotwireless.ListWirelessGatewaysInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListWirelessGatewaysPagesWithContext(ctx, input, func(page *iotwireless.ListWirelessGatewaysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.WirelessGatewayList {
+			r := ResourceWirelessGateway()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Wireless Wireless Gateway sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Wireless Wireless Gateways (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Wireless Wireless Gateways (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,470 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotwireless
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotwireless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotwireless_wireless_gateway", name="Wireless Gateway")
+// @Tags(identifierAttribute="arn")
+func ResourceWirelessGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWirelessGatewayCreate,
+		ReadWithoutTimeout:   resourceWirelessGatewayRead,
+		UpdateWithoutTimeout: resourceWirelessGatewayUpdate,
+		DeleteWithoutTimeout: resourceWirelessGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"lorawan": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"beaconing": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_rate": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(0, 15),
+									},
+									"frequencies": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 10,
+										Elem: &schema.Schema{
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(100000000, 1000000000),
+										},
+									},
+								},
+							},
+						},
+						"gateway_eui": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(([0-9A-Fa-f]{2}-){7}|([0-9A-Fa-f]{2}:){7}|([0-9A-Fa-f]{2}\s){7}|([0-9A-Fa-f]{2}){7})([0-9A-Fa-f]{2})$`), "must be a valid gateway EUI"),
+						},
+						"join_eui_filters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Fa-f]{16}$`), "must be a 16 character hexadecimal string"),
+									},
+									"start": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Fa-f]{16}$`), "must be a 16 character hexadecimal string"),
+									},
+								},
+							},
+						},
+						"max_eirp": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(0, 30),
+						},
+						"net_id_filters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Fa-f]{6}$`), "must be a 6 character hexadecimal string"),
+							},
+						},
+						"rf_region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(iotwireless.SupportedRfRegion_Values(), false),
+						},
+						"sub_bands": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 8,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(1, 8),
+							},
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"thing_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"thing_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceWirelessGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotwireless.CreateWirelessGatewayInput{
+		LoRaWAN: expandLoRaWANGateway(d.Get("lorawan").([]interface{})[0].(map[string]interface{})),
+		Tags:    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if name != "" {
+		input.Name = aws.String(name)
+	}
+
+	output, err := conn.CreateWirelessGatewayWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Wireless Wireless Gateway (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return append(diags, resourceWirelessGatewayRead(ctx, d, meta)...)
+}
+
+func resourceWirelessGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	output, err := FindWirelessGatewayByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Wireless Wireless Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Wireless Wireless Gateway (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrDescription, output.Description)
+	if output.LoRaWAN != nil {
+		if err := d.Set("lorawan", []interface{}{flattenLoRaWANGateway(output.LoRaWAN)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting lorawan: %s", err)
+		}
+	} else {
+		d.Set("lorawan", nil)
+	}
+	d.Set(names.AttrName, output.Name)
+	d.Set("thing_arn", output.ThingArn)
+	d.Set("thing_name", output.ThingName)
+
+	return diags
+}
+
+func resourceWirelessGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotwireless.UpdateWirelessGatewayInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		if d.HasChange("lorawan") {
+			apiObject := expandLoRaWANGateway(d.Get("lorawan").([]interface{})[0].(map[string]interface{}))
+
+			if d.HasChange("lorawan.0.join_eui_filters") {
+				input.JoinEuiFilters = apiObject.JoinEuiFilters
+
+				if input.JoinEuiFilters == nil {
+					input.JoinEuiFilters = [][]*string{}
+				}
+			}
+
+			if d.HasChange("lorawan.0.max_eirp") {
+				input.MaxEirp = apiObject.MaxEirp
+			}
+
+			if d.HasChange("lorawan.0.net_id_filters") {
+				input.NetIdFilters = apiObject.NetIdFilters
+
+				if input.NetIdFilters == nil {
+					input.NetIdFilters = []*string{}
+				}
+			}
+		}
+
+		_, err := conn.UpdateWirelessGatewayWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Wireless Wireless Gateway (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceWirelessGatewayRead(ctx, d, meta)...)
+}
+
+func resourceWirelessGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTWirelessConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Wireless Wireless Gateway: %s", d.Id())
+	_, err := conn.DeleteWirelessGatewayWithContext(ctx, &iotwireless.DeleteWirelessGatewayInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotwireless.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Wireless Wireless Gateway (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindWirelessGatewayByID(ctx context.Context, conn *iotwireless.IoTWireless, id string) (*iotwireless.GetWirelessGatewayOutput, error) {
+	input := &iotwireless.GetWirelessGatewayInput{
+		Identifier:     aws.String(id),
+		IdentifierType: aws.String(iotwireless.WirelessGatewayIdTypeWirelessGatewayId),
+	}
+
+	output, err := conn.GetWirelessGatewayWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotwireless.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandLoRaWANGateway(tfMap map[string]interface{}) *iotwireless.LoRaWANGateway {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotwireless.LoRaWANGateway{}
+
+	if v, ok := tfMap["beaconing"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Beaconing = expandBeaconing(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["gateway_eui"].(string); ok && v != "" {
+		apiObject.GatewayEui = aws.String(v)
+	}
+
+	if v, ok := tfMap["join_eui_filters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.JoinEuiFilters = expandJoinEUIFilters(v)
+	}
+
+	if v, ok := tfMap["max_eirp"].(float64); ok && v != 0 {
+		apiObject.MaxEirp = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["net_id_filters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.NetIdFilters = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["rf_region"].(string); ok && v != "" {
+		apiObject.RfRegion = aws.String(v)
+	}
+
+	if v, ok := tfMap["sub_bands"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SubBands = flex.ExpandInt64List(v)
+	}
+
+	return apiObject
+}
+
+func expandBeaconing(tfMap map[string]interface{}) *iotwireless.Beaconing {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotwireless.Beaconing{}
+
+	if v, ok := tfMap["data_rate"].(int); ok && v != 0 {
+		apiObject.DataRate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["frequencies"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Frequencies = flex.ExpandInt64List(v)
+	}
+
+	return apiObject
+}
+
+func expandJoinEUIFilters(tfList []interface{}) [][]*string {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects [][]*string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, aws.StringSlice([]string{tfMap["start"].(string), tfMap["end"].(string)}))
+	}
+
+	return apiObjects
+}
+
+func flattenLoRaWANGateway(apiObject *iotwireless.LoRaWANGateway) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Beaconing; v != nil {
+		tfMap["beaconing"] = []interface{}{flattenBeaconing(v)}
+	}
+
+	if v := apiObject.GatewayEui; v != nil {
+		tfMap["gateway_eui"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.JoinEuiFilters; v != nil {
+		tfMap["join_eui_filters"] = flattenJoinEUIFilters(v)
+	}
+
+	if v := apiObject.MaxEirp; v != nil {
+		tfMap["max_eirp"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.NetIdFilters; v != nil {
+		tfMap["net_id_filters"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.RfRegion; v != nil {
+		tfMap["rf_region"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SubBands; v != nil {
+		tfMap["sub_bands"] = flex.FlattenInt64List(v)
+	}
+
+	return tfMap
+}
+
+func flattenBeaconing(apiObject *iotwireless.Beaconing) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DataRate; v != nil {
+		tfMap["data_rate"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Frequencies; v != nil {
+		tfMap["frequencies"] = flex.FlattenInt64List(v)
+	}
+
+	return tfMap
+}
+
+func flattenJoinEUIFilters(apiObjects [][]*string) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if len(apiObject) != 2 {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"end":   aws.StringValue(apiObject[1]),
+			"start": aws.StringValue(apiObject[0]),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotwireless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/iotwireless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotwireless "github.com/hashicorp/terraform-provider-aws/internal/service/iotwireless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTWirelessWirelessGateway_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotwireless.GetWirelessGatewayOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	gatewayEUI := sdkacctest.RandStringFromCharSet(16, "0123456789abcdef")
+	resourceName := "aws_iotwireless_wireless_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTWirelessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWirelessGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWirelessGatewayConfig_basic(rName, gatewayEUI),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWirelessGatewayExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iotwireless", regexache.MustCompile(`WirelessGateway/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.gateway_eui", gatewayEUI),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.rf_region", "US915"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTWirelessWirelessGateway_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotwireless.GetWirelessGatewayOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	gatewayEUI := sdkacctest.RandStringFromCharSet(16, "0123456789abcdef")
+	resourceName := "aws_iotwireless_wireless_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTWirelessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWirelessGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWirelessGatewayConfig_basic(rName, gatewayEUI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWirelessGatewayExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotwireless.ResourceWirelessGateway(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTWirelessWirelessGateway_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotwireless.GetWirelessGatewayOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	gatewayEUI := sdkacctest.RandStringFromCharSet(16, "0123456789abcdef")
+	resourceName := "aws_iotwireless_wireless_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTWirelessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWirelessGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWirelessGatewayConfig_basic(rName, gatewayEUI),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWirelessGatewayExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.join_eui_filters.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.net_id_filters.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccWirelessGatewayConfig_filters(rName, gatewayEUI),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWirelessGatewayExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "LoRaWAN gateway"),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.join_eui_filters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.join_eui_filters.0.start", "0000000000000001"),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.join_eui_filters.0.end", "00000000000000ff"),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.net_id_filters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "lorawan.0.net_id_filters.0", "000001"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWirelessGatewayExists(ctx context.Context, n string, v *iotwireless.GetWirelessGatewayOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTWirelessConn(ctx)
+
+		output, err := tfiotwireless.FindWirelessGatewayByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckWirelessGatewayDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTWirelessConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotwireless_wireless_gateway" {
+				continue
+			}
+
+			_, err := tfiotwireless.FindWirelessGatewayByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Wireless Wireless Gateway %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccWirelessGatewayConfig_basic(rName, gatewayEUI string) string {
+	return fmt.Sprintf(`
+resource "aws_iotwireless_wireless_gateway" "test" {
+  name = %[1]q
+
+  lorawan {
+    gateway_eui = %[2]q
+    rf_region   = "US915"
+  }
+}
+`, rName, gatewayEUI)
+}
+
+func testAccWirelessGatewayConfig_filters(rName, gatewayEUI string) string {
+	return fmt.Sprintf(`
+resource "aws_iotwireless_wireless_gateway" "test" {
+  name        = "%[1]s-updated"
+  description = "LoRaWAN gateway"
+
+  lorawan {
+    gateway_eui    = %[2]q
+    net_id_filters = ["000001"]
+    rf_region      = "US915"
+
+    join_eui_filters {
+      start = "0000000000000001"
+      end   = "00000000000000ff"
+    }
+  }
+}
+`, rName, gatewayEUI)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -40,6 +41,14 @@ func resourceAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"canary_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"canary_weight": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -78,6 +87,16 @@ func resourceAlias() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			validateAliasRoutingConfig,
+			customdiff.ComputedIf("canary_version", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("routing_config")
+			}),
+			customdiff.ComputedIf("canary_weight", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("routing_config")
+			}),
+		),
 	}
 }
 
@@ -124,6 +143,9 @@ func resourceAliasRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	aliasARN := aws.ToString(output.AliasArn)
 	d.SetId(aliasARN) // For import.
 	d.Set(names.AttrARN, aliasARN)
+	canaryVersion, canaryWeight := aliasCanary(output.RoutingConfig)
+	d.Set("canary_version", canaryVersion)
+	d.Set("canary_weight", canaryWeight)
 	d.Set(names.AttrDescription, output.Description)
 	d.Set("function_version", output.FunctionVersion)
 	d.Set("invoke_arn", invokeARN(meta.(*conns.AWSClient), aliasARN))
@@ -249,6 +271,66 @@ func flattenAliasRoutingConfiguration(apiObject *awstypes.AliasRoutingConfigurat
 	return []interface{}{tfMap}
 }
 
+// aliasCanary returns the additional version, if any, that an alias is shifting traffic to
+// and the fraction of traffic that version receives.
+func aliasCanary(apiObject *awstypes.AliasRoutingConfiguration) (string, float64) {
+	if apiObject == nil {
+		return "", 0
+	}
+
+	for version, weight := range apiObject.AdditionalVersionWeights {
+		return version, weight
+	}
+
+	return "", 0
+}
+
+// validateAliasRoutingConfig catches routing configurations at plan time that Lambda would otherwise reject on apply.
+// See https://docs.aws.amazon.com/lambda/latest/dg/configuring-alias-routing.html.
+func validateAliasRoutingConfig(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("routing_config") {
+		return nil
+	}
+
+	tfList := d.Get("routing_config").([]interface{})
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	weights := tfList[0].(map[string]interface{})["additional_version_weights"].(map[string]interface{})
+
+	if len(weights) == 0 {
+		return nil
+	}
+
+	if len(weights) > 1 {
+		return fmt.Errorf("routing_config.additional_version_weights: at most one additional version can be specified, got %d", len(weights))
+	}
+
+	functionVersion := d.Get("function_version").(string)
+
+	for version, weight := range weights {
+		if version == FunctionVersionLatest {
+			return fmt.Errorf("routing_config.additional_version_weights: %s can't be used, traffic can only be shifted to a published version", FunctionVersionLatest)
+		}
+
+		if d.NewValueKnown("function_version") && version == functionVersion {
+			return fmt.Errorf("routing_config.additional_version_weights: version %s is the alias's function_version", version)
+		}
+
+		if w := weight.(float64); w < 0 || w > 1 {
+			return fmt.Errorf("routing_config.additional_version_weights: weight for version %s must be between 0.0 and 1.0, got %g", version, w)
+		}
+	}
+
+	if d.NewValueKnown("function_version") && functionVersion == FunctionVersionLatest {
+		return fmt.Errorf("function_version: %s can't be used with routing_config, the alias must point at a published version", FunctionVersionLatest)
+	}
+
+	return nil
+}
+
 func suppressEquivalentFunctionNameOrARN(k, old, new string, d *schema.ResourceData) bool {
 	// Using function name or ARN should not be shown as a diff.
 	// Try to convert the old and new values from ARN to function name
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -208,6 +209,8 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 					testAccCheckAliasAttributes(&conf),
 					testAccCheckAliasRoutingExistsConfig(&conf),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "lambda", functionArnResourcePart),
+					resource.TestCheckResourceAttr(resourceName, "canary_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "canary_weight", "0.5"),
 				),
 			},
 			{
@@ -217,12 +220,44 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 					testAccCheckAliasAttributes(&conf),
 					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "lambda", functionArnResourcePart),
+					resource.TestCheckResourceAttr(resourceName, "canary_version", ""),
+					resource.TestCheckResourceAttr(resourceName, "canary_weight", acctest.Ct0),
 				),
 			},
 		},
 	})
 }
 
+func TestAccLambdaAlias_routingValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAliasConfig_routingWeights(rName, "1", `"2" = 1.5`),
+				ExpectError: regexache.MustCompile(`weight for version 2 must be between 0.0 and 1.0`),
+			},
+			{
+				Config:      testAccAliasConfig_routingWeights(rName, "1", `"1" = 0.5`),
+				ExpectError: regexache.MustCompile(`version 1 is the alias's function_version`),
+			},
+			{
+				Config:      testAccAliasConfig_routingWeights(rName, "1", `"2" = 0.5, "3" = 0.1`),
+				ExpectError: regexache.MustCompile(`at most one additional version can be specified`),
+			},
+			{
+				Config:      testAccAliasConfig_routingWeights(rName, "$LATEST", `"2" = 0.5`),
+				ExpectError: regexache.MustCompile(`function_version: \$LATEST can't be used with routing_config`),
+			},
+		},
+	})
+}
+
 func testAccCheckAliasDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -452,3 +487,30 @@ resource "aws_lambda_alias" "test" {
 }
 `, funcName, aliasName))
 }
+
+func testAccAliasConfig_routingWeights(rName, functionVersion, weights string) string {
+	return acctest.ConfigCompose(
+		testAccAliasConfig_base(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+  publish       = true
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.arn
+  function_version = %[2]q
+
+  routing_config {
+    additional_version_weights = {
+      %[3]s
+    }
+  }
+}
+`, rName, functionVersion, weights))
+}
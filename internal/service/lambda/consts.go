@@ -18,8 +18,9 @@ const (
 )
 
 const (
-	iamPropagationTimeout    = 2 * time.Minute
-	lambdaPropagationTimeout = 5 * time.Minute // nosemgrep:ci.lambda-in-const-name, ci.lambda-in-var-name
+	codeSigningConfigPropagationTimeout = 30 * time.Second
+	iamPropagationTimeout               = 2 * time.Minute
+	lambdaPropagationTimeout            = 5 * time.Minute // nosemgrep:ci.lambda-in-const-name, ci.lambda-in-var-name
 )
 
 type invocationAction string
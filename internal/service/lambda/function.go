@@ -8,11 +8,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -210,6 +213,10 @@ func resourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"image_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -314,6 +321,12 @@ func resourceFunction() *schema.Resource {
 				Default:      -1,
 				ValidateFunc: validation.IntAtLeast(-1),
 			},
+			"resolve_image_digest": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"image_uri"},
+			},
 			names.AttrRole: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -459,6 +472,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			resolveImageDigest,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -587,7 +601,7 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, codeSigningError(err, d.Get("code_signing_config_arn").(string)))
 	}
 
 	d.SetId(functionName)
@@ -675,6 +689,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting image_config: %s", err)
 	}
 	if output.Code != nil {
+		d.Set("image_digest", imageDigestFromURI(aws.ToString(output.Code.ResolvedImageUri)))
 		d.Set("image_uri", output.Code.ImageUri)
 	}
 	d.Set("invoke_arn", invokeARN(meta.(*conns.AWSClient), functionARN))
@@ -693,6 +708,8 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	} else {
 		d.Set("reserved_concurrent_executions", -1)
 	}
+	// Support in-place update of non-refreshable attribute.
+	d.Set("resolve_image_digest", d.Get("resolve_image_digest"))
 	d.Set(names.AttrRole, function.Role)
 	d.Set("runtime", function.Runtime)
 	d.Set("signing_job_arn", function.SigningJobArn)
@@ -779,7 +796,9 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				FunctionName:         aws.String(d.Id()),
 			}
 
-			_, err := conn.PutFunctionCodeSigningConfig(ctx, input)
+			_, err := tfresource.RetryWhenIsA[*awstypes.CodeSigningConfigNotFoundException](ctx, codeSigningConfigPropagationTimeout, func() (interface{}, error) {
+				return conn.PutFunctionCodeSigningConfig(ctx, input)
+			})
 
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) code signing config: %s", d.Id(), err)
@@ -983,7 +1002,7 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				}
 			}
 
-			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), codeSigningError(err, d.Get("code_signing_config_arn").(string)))
 		}
 
 		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -1130,6 +1149,37 @@ func findLatestFunctionVersionByName(ctx context.Context, conn *lambda.Client, n
 	return output, nil
 }
 
+func findImageDigestByTag(ctx context.Context, conn *ecr.Client, registryID, repositoryName, imageTag string) (string, error) {
+	input := &ecr.DescribeImagesInput{
+		ImageIds: []ecrtypes.ImageIdentifier{
+			{
+				ImageTag: aws.String(imageTag),
+			},
+		},
+		RegistryId:     aws.String(registryID),
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	output, err := conn.DescribeImages(ctx, input)
+
+	if errs.IsA[*ecrtypes.ImageNotFoundException](err) || errs.IsA[*ecrtypes.RepositoryNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || len(output.ImageDetails) == 0 || output.ImageDetails[0].ImageDigest == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.ImageDetails[0].ImageDigest), nil
+}
+
 // replaceSecurityGroupsOnDestroy sets the VPC configuration security groups
 // prior to resource destruction
 //
@@ -1270,6 +1320,7 @@ type functionCU interface {
 }
 
 func retryFunctionOp[T functionCU](ctx context.Context, f func() (*T, error)) (*T, error) {
+	start := time.Now()
 	output, err := tfresource.RetryWhen(ctx, lambdaPropagationTimeout,
 		func() (interface{}, error) {
 			return f()
@@ -1293,6 +1344,12 @@ func retryFunctionOp[T functionCU](ctx context.Context, f func() (*T, error)) (*
 				return true, err
 			}
 
+			// A newly created code signing config may not yet be visible to Lambda.
+			// Only retry briefly so that a mistyped ARN fails fast.
+			if errs.IsA[*awstypes.CodeSigningConfigNotFoundException](err) && time.Since(start) < codeSigningConfigPropagationTimeout {
+				return true, err
+			}
+
 			return false, err
 		},
 	)
@@ -1323,6 +1380,21 @@ func retryFunctionOp[T functionCU](ctx context.Context, f func() (*T, error)) (*
 	return output.(*T), err
 }
 
+// codeSigningError adds context to the errors returned when a deployment package fails code signing validation.
+// Lambda returns these as bare HTTP 400 responses that don't mention the code signing config involved.
+func codeSigningError(err error, codeSigningConfigARN string) error {
+	switch {
+	case errs.IsA[*awstypes.CodeSigningConfigNotFoundException](err):
+		return fmt.Errorf("code signing config (%s) not found: %w", codeSigningConfigARN, err)
+	case errs.IsA[*awstypes.CodeVerificationFailedException](err):
+		return fmt.Errorf("deployment package failed code signing validation against code signing config (%s); ensure the package is signed by one of the config's allowed publishers: %w", codeSigningConfigARN, err)
+	case errs.IsA[*awstypes.InvalidCodeSignatureException](err):
+		return fmt.Errorf("deployment package has an invalid, expired or revoked code signature and code signing config (%s) enforces signature validation: %w", codeSigningConfigARN, err)
+	}
+
+	return err
+}
+
 func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	packageType := d.Get("package_type").(string)
 	_, handlerOk := d.GetOk("handler")
@@ -1351,6 +1423,36 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// resolveImageDigest resolves a tagged Amazon ECR image_uri to the digest the tag currently points at
+// so that pushing a new image under a mutable tag shows up as a code change in the plan.
+func resolveImageDigest(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("resolve_image_digest").(bool) || !d.NewValueKnown("image_uri") {
+		return nil
+	}
+
+	imageURI := d.Get("image_uri").(string)
+	registryID, repositoryName, imageTag, ok := parseImageURI(imageURI)
+
+	if !ok {
+		// Not an Amazon ECR image referenced by tag, e.g. already pinned by digest.
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	imageDigest, err := findImageDigestByTag(ctx, conn, registryID, repositoryName, imageTag)
+
+	if err != nil {
+		return fmt.Errorf("resolving Lambda Function image (%s) digest: %w", imageURI, err)
+	}
+
+	if d.Get("image_digest").(string) != imageDigest {
+		return d.SetNew("image_digest", imageDigest)
+	}
+
+	return nil
+}
+
 func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
 		d.HasChange("source_code_hash") ||
@@ -1358,6 +1460,7 @@ func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
 		d.HasChange("image_uri") ||
+		d.HasChange("image_digest") ||
 		d.HasChange("architectures")
 }
 
@@ -1397,6 +1500,30 @@ func readFileContents(v string) ([]byte, error) {
 	return fileContent, nil
 }
 
+// parseImageURI parses an Amazon ECR image URI of the form
+// <registry-id>.dkr.ecr.<region>.amazonaws.com/<repository-name>:<tag>.
+// URIs that reference an image by digest or that don't point at Amazon ECR aren't parsed.
+func parseImageURI(v string) (string, string, string, bool) {
+	m := imageURIRegexp.FindStringSubmatch(v)
+
+	if m == nil {
+		return "", "", "", false
+	}
+
+	return m[1], m[2], m[3], true
+}
+
+var imageURIRegexp = regexache.MustCompile(`^(\d{12})\.dkr\.ecr\.[a-z0-9-]+\.amazonaws\.com(?:\.cn)?/([^:@]+):([^:@]+)$`)
+
+// imageDigestFromURI returns the digest portion of a <repository-uri>@<digest> image URI.
+func imageDigestFromURI(v string) string {
+	if _, digest, ok := strings.Cut(v, "@"); ok {
+		return digest
+	}
+
+	return ""
+}
+
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/set-up-lambda-custom-integrations.html.
 func invokeARN(c *conns.AWSClient, functionOrAliasARN string) string {
 	return arn.ARN{
@@ -6,9 +6,11 @@ package lambda_test
 import (
 	"archive/zip"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -39,6 +41,59 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+func TestCodeSigningError(t *testing.T) {
+	t.Parallel()
+
+	const codeSigningConfigARN = "arn:aws:lambda:us-west-2:123456789012:code-signing-config:csc-0123456789abcdef0" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		err             error
+		expectedMessage string
+	}{
+		"CodeSigningConfigNotFoundException": {
+			err:             &awstypes.CodeSigningConfigNotFoundException{Message: aws.String("not found")},
+			expectedMessage: "code signing config (" + codeSigningConfigARN + ") not found",
+		},
+		"CodeVerificationFailedException": {
+			err:             &awstypes.CodeVerificationFailedException{Message: aws.String("verification failed")},
+			expectedMessage: "deployment package failed code signing validation against code signing config (" + codeSigningConfigARN + ")",
+		},
+		"InvalidCodeSignatureException": {
+			err:             &awstypes.InvalidCodeSignatureException{Message: aws.String("invalid signature")},
+			expectedMessage: "deployment package has an invalid, expired or revoked code signature and code signing config (" + codeSigningConfigARN + ")",
+		},
+		"other error": {
+			err: &awstypes.InvalidParameterValueException{Message: aws.String("invalid parameter")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tflambda.CodeSigningError(testCase.err, codeSigningConfigARN)
+
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("expected error to wrap %q, got %q", testCase.err, err)
+			}
+
+			if testCase.expectedMessage == "" {
+				if err != testCase.err {
+					t.Errorf("expected error to be returned unchanged, got %q", err)
+				}
+
+				return
+			}
+
+			if got := err.Error(); !strings.HasPrefix(got, testCase.expectedMessage) {
+				t.Errorf("expected error message to start with %q, got %q", testCase.expectedMessage, got)
+			}
+		})
+	}
+}
+
 func TestAccLambdaFunction_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf lambda.GetFunctionOutput
@@ -995,6 +1050,43 @@ func TestAccLambdaFunction_image(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_imageResolveDigest(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "AWS_LAMBDA_IMAGE_LATEST_ID"
+	imageLatestID := os.Getenv(key)
+	if imageLatestID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_imageResolveDigest(rName, imageLatestID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "image_uri", imageLatestID),
+					resource.TestMatchResourceAttr(resourceName, "image_digest", regexache.MustCompile(`^sha256:[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, "resolve_image_digest", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish", "resolve_image_digest"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_architectures(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3185,6 +3277,20 @@ resource "aws_lambda_function" "test" {
 `, imageID, rName))
 }
 
+func testAccFunctionConfig_imageResolveDigest(rName, imageID string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  image_uri            = %[1]q
+  function_name        = %[2]q
+  role                 = aws_iam_role.iam_for_lambda.arn
+  package_type         = "Image"
+  resolve_image_digest = true
+}
+`, imageID, rName))
+}
+
 func testAccFunctionConfig_imageUpdateCode(rName, imageID string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_lambda_function_versions", name="Function Versions")
+func dataSourceFunctionVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFunctionVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_sha256": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"qualified_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFunctionVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	functionName := d.Get("function_name").(string)
+	versions, err := findPublishedFunctionVersionsByName(ctx, conn, functionName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) versions: %s", functionName, err)
+	}
+
+	var latestVersion string
+	tfList := make([]interface{}, 0, len(versions))
+	for _, v := range versions {
+		tfList = append(tfList, map[string]interface{}{
+			"code_sha256":         aws.ToString(v.CodeSha256),
+			names.AttrDescription: aws.ToString(v.Description),
+			"last_modified":       aws.ToString(v.LastModified),
+			"qualified_arn":       aws.ToString(v.FunctionArn),
+			names.AttrVersion:     aws.ToString(v.Version),
+		})
+		// List is sorted from oldest to latest.
+		latestVersion = aws.ToString(v.Version)
+	}
+
+	d.SetId(functionName)
+	d.Set("latest_version", latestVersion)
+	if err := d.Set("versions", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting versions: %s", err)
+	}
+
+	return diags
+}
+
+func findPublishedFunctionVersionsByName(ctx context.Context, conn *lambda.Client, name string) ([]awstypes.FunctionConfiguration, error) {
+	input := &lambda.ListVersionsByFunctionInput{
+		FunctionName: aws.String(name),
+		MaxItems:     aws.Int32(listVersionsMaxItems),
+	}
+	var output []awstypes.FunctionConfiguration
+
+	pages := lambda.NewListVersionsByFunctionPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Versions {
+			if aws.ToString(v.Version) == FunctionVersionLatest {
+				continue
+			}
+
+			output = append(output, v)
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaFunctionVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_function_versions.test"
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "latest_version", resourceName, names.AttrVersion),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.description", "version one"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0.qualified_arn", resourceName, "qualified_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0.version", resourceName, names.AttrVersion),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.0.code_sha256"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.0.last_modified"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFunctionVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  description   = "version one"
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+  publish       = true
+}
+
+data "aws_lambda_function_versions" "test" {
+  function_name = aws_lambda_function.test.function_name
+
+  depends_on = [aws_lambda_function.test]
+}
+`, rName))
+}
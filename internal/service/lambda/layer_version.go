@@ -34,6 +34,7 @@ func resourceLayerVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLayerVersionCreate,
 		ReadWithoutTimeout:   resourceLayerVersionRead,
+		UpdateWithoutTimeout: resourceLayerVersionUpdate,
 		DeleteWithoutTimeout: resourceLayerVersionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -129,7 +130,6 @@ func resourceLayerVersion() *schema.Resource {
 			names.AttrSkipDestroy: {
 				Type:     schema.TypeBool,
 				Default:  false,
-				ForceNew: true,
 				Optional: true,
 			},
 			"source_code_hash": {
@@ -248,6 +248,8 @@ func resourceLayerVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("license_info", output.LicenseInfo)
 	d.Set("signing_job_arn", output.Content.SigningJobArn)
 	d.Set("signing_profile_version_arn", output.Content.SigningProfileVersionArn)
+	// Support in-place update of non-refreshable attribute.
+	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
 	d.Set("source_code_hash", d.Get("source_code_hash"))
 	d.Set("source_code_size", output.Content.CodeSize)
 	d.Set(names.AttrVersion, strconv.FormatInt(versionNumber, 10))
@@ -255,6 +257,14 @@ func resourceLayerVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceLayerVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only skip_destroy can be updated in-place and it isn't sent to AWS.
+
+	return append(diags, resourceLayerVersionRead(ctx, d, meta)...)
+}
+
 func resourceLayerVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
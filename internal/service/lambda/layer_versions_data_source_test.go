@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaLayerVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "latest_version", resourceName, names.AttrVersion),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0.version", resourceName, names.AttrVersion),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0.description", resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0.created_date", resourceName, names.AttrCreatedDate),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.compatible_runtimes.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccLayerVersionsDataSourceConfig_runtime(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "latest_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccLayerVersionsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test1" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  description         = "version one"
+  compatible_runtimes = ["nodejs18.x"]
+}
+
+resource "aws_lambda_layer_version" "test2" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  description         = "version two"
+  compatible_runtimes = ["nodejs20.x"]
+
+  depends_on = [aws_lambda_layer_version.test1]
+}
+`, rName)
+}
+
+func testAccLayerVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name = aws_lambda_layer_version.test2.layer_name
+
+  depends_on = [aws_lambda_layer_version.test1, aws_lambda_layer_version.test2]
+}
+`)
+}
+
+func testAccLayerVersionsDataSourceConfig_runtime(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name         = aws_lambda_layer_version.test2.layer_name
+  compatible_runtime = "nodejs18.x"
+
+  depends_on = [aws_lambda_layer_version.test1, aws_lambda_layer_version.test2]
+}
+`)
+}
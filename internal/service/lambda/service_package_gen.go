@@ -50,6 +50,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_lambda_function_url",
 			Name:     "Function URL",
 		},
+		{
+			Factory:  dataSourceFunctionVersions,
+			TypeName: "aws_lambda_function_versions",
+			Name:     "Function Versions",
+		},
 		{
 			Factory:  dataSourceFunctions,
 			TypeName: "aws_lambda_functions",
@@ -65,6 +70,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_lambda_layer_version",
 			Name:     "Layer Version",
 		},
+		{
+			Factory:  dataSourceLayerVersions,
+			TypeName: "aws_lambda_layer_versions",
+			Name:     "Layer Versions",
+		},
 	}
 }
 
@@ -42,6 +42,9 @@ func (d *dataSourceDefaultTags) Schema(ctx context.Context, req datasource.Schem
 				Optional: true,
 				Computed: true,
 			},
+			names.AttrResourceType: schema.StringAttribute{
+				Optional: true,
+			},
 			names.AttrTags: tftags.TagsAttributeComputedOnly(),
 		},
 	}
@@ -59,6 +62,10 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 	}
 
 	defaultTagsConfig := d.Meta().DefaultTagsConfig
+	// Resource types excluded from default tagging have no default tags.
+	if resourceType := data.ResourceType.ValueString(); resourceType != "" {
+		defaultTagsConfig = defaultTagsConfig.ForResourceType(resourceType)
+	}
 	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
 	tags := defaultTagsConfig.GetTags()
 
@@ -69,6 +76,7 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 }
 
 type dataSourceDefaultTagsData struct {
-	ID   types.String `tfsdk:"id"`
-	Tags types.Map    `tfsdk:"tags"`
+	ID           types.String `tfsdk:"id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Tags         types.Map    `tfsdk:"tags"`
 }
@@ -373,6 +373,19 @@ func FindCoreNetworkPolicyByTwoPartKey(ctx context.Context, conn *networkmanager
 		input.PolicyVersionId = aws.Int64(policyVersionID)
 	}
 
+	return findCoreNetworkPolicy(ctx, conn, input)
+}
+
+func findCoreNetworkPolicyByAlias(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID, alias string) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		Alias:         aws.String(alias),
+		CoreNetworkId: aws.String(coreNetworkID),
+	}
+
+	return findCoreNetworkPolicy(ctx, conn, input)
+}
+
+func findCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetCoreNetworkPolicyInput) (*networkmanager.CoreNetworkPolicy, error) {
 	output, err := conn.GetCoreNetworkPolicyWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
@@ -393,6 +406,41 @@ func FindCoreNetworkPolicyByTwoPartKey(ctx context.Context, conn *networkmanager
 	return output.CoreNetworkPolicy, nil
 }
 
+func findCoreNetworkChangeSetByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64) ([]*networkmanager.CoreNetworkChange, error) {
+	input := &networkmanager.GetCoreNetworkChangeSetInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	}
+	var output []*networkmanager.CoreNetworkChange
+
+	err := conn.GetCoreNetworkChangeSetPagesWithContext(ctx, input, func(page *networkmanager.GetCoreNetworkChangeSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CoreNetworkChanges {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func statusCoreNetworkState(ctx context.Context, conn *networkmanager.NetworkManager, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindCoreNetworkByID(ctx, conn, id)
@@ -543,10 +591,22 @@ func flattenCoreNetworkSegments(apiObjects []*networkmanager.CoreNetworkSegment)
 }
 
 func PutAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId, policyDocument string) error {
+	policyVersionID, err := putCoreNetworkPolicy(ctx, conn, coreNetworkId, policyDocument)
+
+	if err != nil {
+		return err
+	}
+
+	return executeCoreNetworkChangeSet(ctx, conn, coreNetworkId, policyVersionID)
+}
+
+// putCoreNetworkPolicy creates a new policy version and waits for its change set to be generated.
+// The change set is not executed.
+func putCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId, policyDocument string) (int64, error) {
 	v, err := protocol.DecodeJSONValue(policyDocument, protocol.NoEscape)
 
 	if err != nil {
-		return fmt.Errorf("decoding Network Manager Core Network (%s) policy document: %s", coreNetworkId, err)
+		return 0, fmt.Errorf("decoding Network Manager Core Network (%s) policy document: %s", coreNetworkId, err)
 	}
 
 	output, err := conn.PutCoreNetworkPolicyWithContext(ctx, &networkmanager.PutCoreNetworkPolicyInput{
@@ -556,16 +616,41 @@ func PutAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.Ne
 	})
 
 	if err != nil {
-		return fmt.Errorf("putting Network Manager Core Network (%s) policy: %s", coreNetworkId, err)
+		return 0, fmt.Errorf("putting Network Manager Core Network (%s) policy: %s", coreNetworkId, err)
 	}
 
 	policyVersionID := aws.Int64Value(output.CoreNetworkPolicy.PolicyVersionId)
 
 	if _, err := waitCoreNetworkPolicyCreated(ctx, conn, coreNetworkId, policyVersionID, waitCoreNetworkPolicyCreatedTimeInMinutes*time.Minute); err != nil {
-		return fmt.Errorf("waiting for Network Manager Core Network Policy from Core Network (%s) create: %s", coreNetworkId, err)
+		return 0, fmt.Errorf("waiting for Network Manager Core Network Policy from Core Network (%s) create: %s", coreNetworkId, err)
+	}
+
+	return policyVersionID, nil
+}
+
+// restoreCoreNetworkPolicyVersion creates a new policy version from a previous one and waits for its change set to be generated.
+// The change set is not executed.
+func restoreCoreNetworkPolicyVersion(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId string, policyVersionID int64) (int64, error) {
+	output, err := conn.RestoreCoreNetworkPolicyVersionWithContext(ctx, &networkmanager.RestoreCoreNetworkPolicyVersionInput{
+		CoreNetworkId:   aws.String(coreNetworkId),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("restoring Network Manager Core Network (%s) policy version (%d): %s", coreNetworkId, policyVersionID, err)
+	}
+
+	restoredPolicyVersionID := aws.Int64Value(output.CoreNetworkPolicy.PolicyVersionId)
+
+	if _, err := waitCoreNetworkPolicyCreated(ctx, conn, coreNetworkId, restoredPolicyVersionID, waitCoreNetworkPolicyCreatedTimeInMinutes*time.Minute); err != nil {
+		return 0, fmt.Errorf("waiting for Network Manager Core Network Policy from Core Network (%s) create: %s", coreNetworkId, err)
 	}
 
-	_, err = conn.ExecuteCoreNetworkChangeSetWithContext(ctx, &networkmanager.ExecuteCoreNetworkChangeSetInput{
+	return restoredPolicyVersionID, nil
+}
+
+func executeCoreNetworkChangeSet(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId string, policyVersionID int64) error {
+	_, err := conn.ExecuteCoreNetworkChangeSetWithContext(ctx, &networkmanager.ExecuteCoreNetworkChangeSetInput{
 		CoreNetworkId:   aws.String(coreNetworkId),
 		PolicyVersionId: aws.Int64(policyVersionID),
 	})
@@ -592,6 +677,23 @@ func statusCoreNetworkPolicyState(ctx context.Context, conn *networkmanager.Netw
 	}
 }
 
+func waitCoreNetworkPolicyExecuted(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId string, policyVersionId int64, timeout time.Duration) (*networkmanager.CoreNetworkPolicy, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{networkmanager.ChangeSetStateReadyToExecute, networkmanager.ChangeSetStateExecuting},
+		Target:  []string{networkmanager.ChangeSetStateExecutionSucceeded},
+		Timeout: timeout,
+		Refresh: statusCoreNetworkPolicyState(ctx, conn, coreNetworkId, policyVersionId),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetworkPolicy); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitCoreNetworkPolicyCreated(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId string, policyVersionId int64, timeout time.Duration) (*networkmanager.CoreNetworkPolicy, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{networkmanager.ChangeSetStatePendingGeneration},
@@ -5,12 +5,17 @@ package networkmanager
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,7 +42,54 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("change_set", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("policy_document")
+			}),
+			customdiff.ComputedIf("change_set_state", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChanges("approved_policy_version_id", "policy_document", "require_approval")
+			}),
+			customdiff.ComputedIf("policy_version_id", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("policy_document")
+			}),
+		),
+
 		Schema: map[string]*schema.Schema{
+			"approved_policy_version_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(minimumValidPolicyVersionID),
+			},
+			"change_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrIdentifier: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"new_values":      coreNetworkChangeValuesSchema(),
+						"previous_values": coreNetworkChangeValuesSchema(),
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"change_set_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"core_network_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -60,6 +112,18 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 					return json
 				},
 			},
+			"policy_version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"require_approval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"rollback_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -68,6 +132,48 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 	}
 }
 
+func coreNetworkChangeValuesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"asn": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"cidr": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"destination_identifier": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"edge_locations": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"inside_cidr_blocks": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"segment_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"shared_segments": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
 func resourceCoreNetworkPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId(d.Get("core_network_id").(string))
 
@@ -99,18 +205,39 @@ func resourceCoreNetworkPolicyAttachmentRead(ctx context.Context, d *schema.Reso
 	coreNetworkPolicy, err := FindCoreNetworkPolicyByTwoPartKey(ctx, conn, d.Id(), latestPolicyVersionID)
 
 	if tfresource.NotFound(err) {
+		d.Set("change_set", nil)
+		d.Set("change_set_state", nil)
 		d.Set("policy_document", nil)
-	} else if err != nil {
+		d.Set("policy_version_id", nil)
+		return diags
+	}
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Network Manager Core Network (%s) policy: %s", d.Id(), err)
-	} else {
-		encodedPolicyDocument, err := protocol.EncodeJSONValue(coreNetworkPolicy.PolicyDocument, protocol.NoEscape)
+	}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "encoding Network Manager Core Network (%s) policy document: %s", d.Id(), err)
-		}
+	encodedPolicyDocument, err := protocol.EncodeJSONValue(coreNetworkPolicy.PolicyDocument, protocol.NoEscape)
 
-		d.Set("policy_document", encodedPolicyDocument)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "encoding Network Manager Core Network (%s) policy document: %s", d.Id(), err)
 	}
+
+	policyVersionID := aws.Int64Value(coreNetworkPolicy.PolicyVersionId)
+
+	d.Set("change_set_state", coreNetworkPolicy.ChangeSetState)
+	d.Set("policy_document", encodedPolicyDocument)
+	d.Set("policy_version_id", policyVersionID)
+
+	changes, err := findCoreNetworkChangeSetByTwoPartKey(ctx, conn, d.Id(), policyVersionID)
+
+	if err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading Network Manager Core Network (%s) change set (%d): %s", d.Id(), policyVersionID, err)
+	}
+
+	if err := d.Set("change_set", flattenCoreNetworkChanges(changes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting change_set: %s", err)
+	}
+
 	return diags
 }
 
@@ -119,17 +246,141 @@ func resourceCoreNetworkPolicyAttachmentUpdate(ctx context.Context, d *schema.Re
 
 	conn := meta.(*conns.AWSClient).NetworkManagerConn(ctx)
 
+	requireApproval := d.Get("require_approval").(bool)
+	approvedPolicyVersionID := int64(d.Get("approved_policy_version_id").(int))
+	rollback := d.Get("rollback_on_failure").(bool)
+
 	if d.HasChange("policy_document") {
-		err := PutAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string))
+		policyVersionID, err := putCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string))
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
-		if _, err := waitCoreNetworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Core Network (%s) update: %s", d.Id(), err)
+		// When approval is required the change set is left ready to execute for review.
+		if !requireApproval || approvedPolicyVersionID == policyVersionID {
+			if err := executeCoreNetworkPolicyAttachmentChangeSet(ctx, conn, d.Id(), policyVersionID, rollback, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	} else if d.HasChanges("approved_policy_version_id", "require_approval") {
+		coreNetworkPolicy, err := FindCoreNetworkPolicyByTwoPartKey(ctx, conn, d.Id(), latestPolicyVersionID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Network Manager Core Network (%s) policy: %s", d.Id(), err)
+		}
+
+		policyVersionID := aws.Int64Value(coreNetworkPolicy.PolicyVersionId)
+
+		if aws.StringValue(coreNetworkPolicy.ChangeSetState) == networkmanager.ChangeSetStateReadyToExecute && (!requireApproval || approvedPolicyVersionID == policyVersionID) {
+			if err := executeCoreNetworkPolicyAttachmentChangeSet(ctx, conn, d.Id(), policyVersionID, rollback, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
 		}
 	}
 
 	return append(diags, resourceCoreNetworkPolicyAttachmentRead(ctx, d, meta)...)
 }
+
+// executeCoreNetworkPolicyAttachmentChangeSet executes the specified policy version's change set.
+// If rollback is set and execution fails, the policy version that was live beforehand is restored.
+func executeCoreNetworkPolicyAttachmentChangeSet(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64, rollback bool, timeout time.Duration) error {
+	var livePolicyVersionID int64
+
+	if rollback {
+		livePolicy, err := findCoreNetworkPolicyByAlias(ctx, conn, coreNetworkID, networkmanager.CoreNetworkPolicyAliasLive)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return fmt.Errorf("reading Network Manager Core Network (%s) live policy: %s", coreNetworkID, err)
+		default:
+			livePolicyVersionID = aws.Int64Value(livePolicy.PolicyVersionId)
+		}
+	}
+
+	err := executeCoreNetworkPolicyVersion(ctx, conn, coreNetworkID, policyVersionID, timeout)
+
+	if err == nil || livePolicyVersionID < minimumValidPolicyVersionID {
+		return err
+	}
+
+	log.Printf("[WARN] Network Manager Core Network (%s) policy version (%d) failed, rolling back to policy version (%d)", coreNetworkID, policyVersionID, livePolicyVersionID)
+
+	restoredPolicyVersionID, rollbackErr := restoreCoreNetworkPolicyVersion(ctx, conn, coreNetworkID, livePolicyVersionID)
+
+	if rollbackErr == nil {
+		rollbackErr = executeCoreNetworkPolicyVersion(ctx, conn, coreNetworkID, restoredPolicyVersionID, timeout)
+	}
+
+	if rollbackErr != nil {
+		return errors.Join(err, fmt.Errorf("rolling back Network Manager Core Network (%s) to policy version (%d): %w", coreNetworkID, livePolicyVersionID, rollbackErr))
+	}
+
+	return fmt.Errorf("%w; rolled back to policy version (%d)", err, livePolicyVersionID)
+}
+
+func executeCoreNetworkPolicyVersion(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64, timeout time.Duration) error {
+	if err := executeCoreNetworkChangeSet(ctx, conn, coreNetworkID, policyVersionID); err != nil {
+		return err
+	}
+
+	if _, err := waitCoreNetworkPolicyExecuted(ctx, conn, coreNetworkID, policyVersionID, timeout); err != nil {
+		return fmt.Errorf("waiting for Network Manager Core Network (%s) change set (%d) execute: %s", coreNetworkID, policyVersionID, err)
+	}
+
+	if _, err := waitCoreNetworkUpdated(ctx, conn, coreNetworkID, timeout); err != nil {
+		return fmt.Errorf("waiting for Network Manager Core Network (%s) update: %s", coreNetworkID, err)
+	}
+
+	return nil
+}
+
+func flattenCoreNetworkChanges(apiObjects []*networkmanager.CoreNetworkChange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrAction:     aws.StringValue(apiObject.Action),
+			names.AttrIdentifier: aws.StringValue(apiObject.Identifier),
+			"identifier_path":    aws.StringValue(apiObject.IdentifierPath),
+			names.AttrType:       aws.StringValue(apiObject.Type),
+		}
+
+		if v := apiObject.NewValues; v != nil {
+			tfMap["new_values"] = []interface{}{flattenCoreNetworkChangeValues(v)}
+		}
+
+		if v := apiObject.PreviousValues; v != nil {
+			tfMap["previous_values"] = []interface{}{flattenCoreNetworkChangeValues(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenCoreNetworkChangeValues(apiObject *networkmanager.CoreNetworkChangeValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"asn":                    aws.Int64Value(apiObject.Asn),
+		"cidr":                   aws.StringValue(apiObject.Cidr),
+		"destination_identifier": aws.StringValue(apiObject.DestinationIdentifier),
+		"edge_locations":         aws.StringValueSlice(apiObject.EdgeLocations),
+		"inside_cidr_blocks":     aws.StringValueSlice(apiObject.InsideCidrBlocks),
+		"segment_name":           aws.StringValue(apiObject.SegmentName),
+		"shared_segments":        aws.StringValueSlice(apiObject.SharedSegments),
+	}
+}
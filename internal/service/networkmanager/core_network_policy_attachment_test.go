@@ -61,6 +61,40 @@ func TestAccNetworkManagerCoreNetworkPolicyAttachment_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkManagerCoreNetworkPolicyAttachment_requireApproval(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_networkmanager_core_network_policy_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCoreNetworkPolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig_requireApproval("segmentValue", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "change_set_state", networkmanager.ChangeSetStateReadyToExecute),
+					resource.TestCheckResourceAttrSet(resourceName, "change_set.#"),
+					resource.TestCheckResourceAttr(resourceName, "policy_version_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "require_approval", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig_requireApproval("segmentValue", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "approved_policy_version_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "change_set_state", networkmanager.ChangeSetStateExecutionSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "policy_version_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, networkmanager.CoreNetworkStateAvailable),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkManagerCoreNetworkPolicyAttachment_vpcAttachment(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_networkmanager_core_network_policy_attachment.test"
@@ -210,6 +244,38 @@ resource "aws_networkmanager_core_network_policy_attachment" "test" {
 `, segmentValue, acctest.Region())
 }
 
+func testAccCoreNetworkPolicyAttachmentConfig_requireApproval(segmentValue string, approvedPolicyVersionID int) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["65022-65534"]
+
+    edge_locations {
+      location = %[2]q
+    }
+  }
+
+  segments {
+    name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_core_network" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_core_network_policy_attachment" "test" {
+  core_network_id            = aws_networkmanager_core_network.test.id
+  policy_document            = data.aws_networkmanager_core_network_policy_document.test.json
+  require_approval           = true
+  approved_policy_version_id = %[3]d == 0 ? null : %[3]d
+  rollback_on_failure        = true
+}
+`, segmentValue, acctest.Region(), approvedPolicyVersionID)
+}
+
 func testAccCoreNetworkPolicyAttachmentConfig_vpcAttachmentCreate() string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_vpc" "test" {
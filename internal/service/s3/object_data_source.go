@@ -5,7 +5,9 @@ package s3
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"os"
 	"regexp"
 	"strings"
 	"time"
@@ -24,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
 )
 
 // @SDKDataSource("aws_s3_object", name="Object")
@@ -130,6 +133,11 @@ func dataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"output_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"skip_body"},
+			},
 			"range": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -142,6 +150,11 @@ func dataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"skip_body": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"output_path"},
+			},
 			names.AttrStorageClass: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -246,9 +259,8 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("version_id", output.VersionId)
 	d.Set("website_redirect_location", output.WebsiteRedirectLocation)
 
-	if isContentTypeAllowed(output.ContentType) {
+	if !d.Get("skip_body").(bool) {
 		downloader := manager.NewDownloader(conn, manager.WithDownloaderClientOptions(optFns...))
-		buf := manager.NewWriteAtBuffer(make([]byte, 0))
 		input := &s3.GetObjectInput{
 			Bucket:    aws.String(bucket),
 			Key:       aws.String(key),
@@ -258,13 +270,22 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 			input.Range = aws.String(v.(string))
 		}
 
-		_, err := downloader.Download(ctx, buf, input)
+		if v, ok := d.GetOk("output_path"); ok {
+			// Stream the object to a local file instead of holding it in memory and state.
+			if err := downloadObjectToFile(ctx, downloader, input, v.(string)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "downloading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+			}
+		} else if isContentTypeAllowed(output.ContentType) {
+			buf := manager.NewWriteAtBuffer(make([]byte, 0))
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "downloading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
-		}
+			_, err := downloader.Download(ctx, buf, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "downloading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+			}
 
-		d.Set("body", string(buf.Bytes()))
+			d.Set("body", string(buf.Bytes()))
+		}
 	}
 
 	if tags, err := objectListTags(ctx, conn, bucket, key, optFns...); err == nil {
@@ -278,6 +299,25 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func downloadObjectToFile(ctx context.Context, downloader *manager.Downloader, input *s3.GetObjectInput, path string) error {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return fmt.Errorf("expanding homedir in output_path (%s): %w", path, err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating output file (%s): %w", path, err)
+	}
+
+	if _, err := downloader.Download(ctx, file, input); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
 // This is to prevent potential issues w/ binary files and generally unprintable characters.
 // See https://github.com/hashicorp/terraform/pull/3858#issuecomment-156856738.
 func isContentTypeAllowed(contentType *string) bool {
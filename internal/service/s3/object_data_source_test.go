@@ -5,12 +5,15 @@ package s3_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -106,6 +109,57 @@ func TestAccS3ObjectDataSource_readableBody(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_skipBody(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_skipBody(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(dataSourceName, "body"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_length", acctest.Ct3),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrContentType, resourceName, names.AttrContentType),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectDataSource_outputPath(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	dataSourceName := "data.aws_s3_object.test"
+	outputPath := filepath.Join(t.TempDir(), "object")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_outputPath(rName, outputPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(dataSourceName, "body"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "output_path", outputPath),
+					testAccCheckObjectDataSourceOutputFile(outputPath, "ello"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectDataSource_kmsEncrypted(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -645,6 +699,49 @@ data "aws_s3_object" "test" {
 `, rName)
 }
 
+func testAccObjectDataSourceConfig_skipBody(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "%[1]s-key"
+  content      = "yes"
+  content_type = "text/plain"
+}
+
+data "aws_s3_object" "test" {
+  bucket    = aws_s3_bucket.test.bucket
+  key       = aws_s3_object.test.key
+  skip_body = true
+}
+`, rName)
+}
+
+func testAccObjectDataSourceConfig_outputPath(rName, outputPath string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "%[1]s-key"
+  content      = "hello"
+  content_type = "binary/octet-stream"
+}
+
+data "aws_s3_object" "test" {
+  bucket      = aws_s3_bucket.test.bucket
+  key         = aws_s3_object.test.key
+  range       = "bytes=1-4"
+  output_path = %[2]q
+}
+`, rName, outputPath)
+}
+
 func testAccObjectDataSourceConfig_kmsEncrypted(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -1048,3 +1145,19 @@ data "aws_s3_object" "test" {
 }
 `, rName))
 }
+
+func testAccCheckObjectDataSourceOutputFile(path, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		got, err := os.ReadFile(path)
+
+		if err != nil {
+			return err
+		}
+
+		if string(got) != want {
+			return fmt.Errorf("output file (%s) content = %q, want %q", path, string(got), want)
+		}
+
+		return nil
+	}
+}
@@ -40,6 +40,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Name:    "Access Grants Location",
 			Tags:    &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory: newStorageLensGroupResource,
+			Name:    "Storage Lens Group",
+			Tags:    &types.ServicePackageResourceTags{},
+		},
 	}
 }
 
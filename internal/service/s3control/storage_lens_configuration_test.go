@@ -324,6 +324,37 @@ func TestAccS3ControlStorageLensConfiguration_advancedMetrics(t *testing.T) {
 	})
 }
 
+func TestAccS3ControlStorageLensConfiguration_storageLensGroupLevel(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageLensConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensConfigurationConfig_storageLensGroupLevel(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.storage_lens_group_level.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.storage_lens_group_level.0.selection_criteria.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.storage_lens_group_level.0.selection_criteria.0.exclude.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.storage_lens_group_level.0.selection_criteria.0.include.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "storage_lens_configuration.0.account_level.0.storage_lens_group_level.0.selection_criteria.0.include.*", "aws_s3control_storage_lens_group.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckStorageLensConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
@@ -620,3 +651,37 @@ resource "aws_s3control_storage_lens_configuration" "test" {
 }
 `, rName)
 }
+
+func testAccStorageLensConfigurationConfig_storageLensGroupLevel(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_group" "test" {
+  name = %[1]q
+
+  filter {
+    match_any_prefix = ["logs/"]
+  }
+}
+
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = true
+
+    account_level {
+      activity_metrics {
+        enabled = true
+      }
+
+      bucket_level {}
+
+      storage_lens_group_level {
+        selection_criteria {
+          include = [aws_s3control_storage_lens_group.test.arn]
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
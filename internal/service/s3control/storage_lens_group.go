@@ -0,0 +1,478 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Storage Lens Group")
+// @Tags
+func newStorageLensGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &storageLensGroupResource{}
+
+	return r, nil
+}
+
+type storageLensGroupResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *storageLensGroupResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3control_storage_lens_group"
+}
+
+func (r *storageLensGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	filterAttributes := map[string]schema.Attribute{
+		"match_any_prefix": schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"match_any_suffix": schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	}
+	filterBlocks := map[string]schema.Block{
+		"match_any_tag": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[s3TagModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrKey: schema.StringAttribute{
+						Required: true,
+					},
+					names.AttrValue: schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+		"match_object_age": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[matchObjectAgeModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"days_greater_than": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"days_less_than": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+		},
+		"match_object_size": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[matchObjectSizeModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"bytes_greater_than": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"bytes_less_than": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+		},
+	}
+	operatorBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[storageLensGroupOperatorModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: filterAttributes,
+			Blocks:     filterBlocks,
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[storageLensGroupFilterModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: filterAttributes,
+					Blocks: map[string]schema.Block{
+						"and":               operatorBlock,
+						"match_any_tag":     filterBlocks["match_any_tag"],
+						"match_object_age":  filterBlocks["match_object_age"],
+						"match_object_size": filterBlocks["match_object_size"],
+						"or":                operatorBlock,
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *storageLensGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data storageLensGroupResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(r.Meta().AccountID)
+	}
+	input := &s3control.CreateStorageLensGroupInput{
+		AccountId:        fwflex.StringFromFramework(ctx, data.AccountID),
+		StorageLensGroup: &awstypes.StorageLensGroup{},
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input.StorageLensGroup)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateStorageLensGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Storage Lens Group (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.setID()
+
+	output, err := findStorageLensGroupByTwoPartKey(ctx, conn, data.AccountID.ValueString(), data.Name.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Storage Lens Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *storageLensGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data storageLensGroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	output, err := findStorageLensGroupByTwoPartKey(ctx, conn, data.AccountID.ValueString(), data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Storage Lens Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := listTags(ctx, conn, data.StorageLensGroupARN.ValueString(), data.AccountID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for S3 Storage Lens Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *storageLensGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new storageLensGroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	if !new.Filter.Equal(old.Filter) {
+		input := &s3control.UpdateStorageLensGroupInput{
+			AccountId:        fwflex.StringFromFramework(ctx, new.AccountID),
+			Name:             fwflex.StringFromFramework(ctx, new.Name),
+			StorageLensGroup: &awstypes.StorageLensGroup{},
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input.StorageLensGroup)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateStorageLensGroup(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating S3 Storage Lens Group (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findStorageLensGroupByTwoPartKey(ctx, conn, new.AccountID.ValueString(), new.Name.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading S3 Storage Lens Group (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
+		if err := updateTags(ctx, conn, new.StorageLensGroupARN.ValueString(), new.AccountID.ValueString(), oldTagsAll, newTagsAll); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating tags for S3 Storage Lens Group (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *storageLensGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data storageLensGroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	_, err := conn.DeleteStorageLensGroup(ctx, &s3control.DeleteStorageLensGroupInput{
+		AccountId: fwflex.StringFromFramework(ctx, data.AccountID),
+		Name:      fwflex.StringFromFramework(ctx, data.Name),
+	})
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Storage Lens Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *storageLensGroupResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findStorageLensGroupByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, name string) (*awstypes.StorageLensGroup, error) {
+	input := &s3control.GetStorageLensGroupInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetStorageLensGroup(ctx, input)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StorageLensGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StorageLensGroup, nil
+}
+
+type storageLensGroupResourceModel struct {
+	AccountID           types.String                                                 `tfsdk:"account_id"`
+	Filter              fwtypes.ListNestedObjectValueOf[storageLensGroupFilterModel] `tfsdk:"filter"`
+	ID                  types.String                                                 `tfsdk:"id"`
+	Name                types.String                                                 `tfsdk:"name"`
+	StorageLensGroupARN types.String                                                 `tfsdk:"arn"`
+	Tags                types.Map                                                    `tfsdk:"tags"`
+	TagsAll             types.Map                                                    `tfsdk:"tags_all"`
+}
+
+type storageLensGroupFilterModel struct {
+	And             fwtypes.ListNestedObjectValueOf[storageLensGroupOperatorModel] `tfsdk:"and"`
+	MatchAnyPrefix  fwtypes.SetValueOf[types.String]                               `tfsdk:"match_any_prefix"`
+	MatchAnySuffix  fwtypes.SetValueOf[types.String]                               `tfsdk:"match_any_suffix"`
+	MatchAnyTag     fwtypes.ListNestedObjectValueOf[s3TagModel]                    `tfsdk:"match_any_tag"`
+	MatchObjectAge  fwtypes.ListNestedObjectValueOf[matchObjectAgeModel]           `tfsdk:"match_object_age"`
+	MatchObjectSize fwtypes.ListNestedObjectValueOf[matchObjectSizeModel]          `tfsdk:"match_object_size"`
+	Or              fwtypes.ListNestedObjectValueOf[storageLensGroupOperatorModel] `tfsdk:"or"`
+}
+
+type storageLensGroupOperatorModel struct {
+	MatchAnyPrefix  fwtypes.SetValueOf[types.String]                      `tfsdk:"match_any_prefix"`
+	MatchAnySuffix  fwtypes.SetValueOf[types.String]                      `tfsdk:"match_any_suffix"`
+	MatchAnyTag     fwtypes.ListNestedObjectValueOf[s3TagModel]           `tfsdk:"match_any_tag"`
+	MatchObjectAge  fwtypes.ListNestedObjectValueOf[matchObjectAgeModel]  `tfsdk:"match_object_age"`
+	MatchObjectSize fwtypes.ListNestedObjectValueOf[matchObjectSizeModel] `tfsdk:"match_object_size"`
+}
+
+type s3TagModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type matchObjectAgeModel struct {
+	DaysGreaterThan types.Int64 `tfsdk:"days_greater_than"`
+	DaysLessThan    types.Int64 `tfsdk:"days_less_than"`
+}
+
+type matchObjectSizeModel struct {
+	BytesGreaterThan types.Int64 `tfsdk:"bytes_greater_than"`
+	BytesLessThan    types.Int64 `tfsdk:"bytes_less_than"`
+}
+
+const (
+	storageLensGroupResourceIDPartCount = 2
+)
+
+func (data *storageLensGroupResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, storageLensGroupResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.AccountID = types.StringValue(parts[0])
+	data.Name = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *storageLensGroupResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.AccountID.ValueString(), data.Name.ValueString()}, storageLensGroupResourceIDPartCount, false)))
+}
@@ -5,8 +5,10 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -57,6 +59,64 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"key1": "value1",
+		}),
+		Excludes: []string{"aws_autoscaling_group", "aws_secretsmanager_secret"},
+	}
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		wantNil       bool
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			typeName:      "aws_vpc",
+			wantNil:       true,
+		},
+		{
+			name:          "no excludes",
+			defaultConfig: &DefaultConfig{},
+			typeName:      "aws_vpc",
+		},
+		{
+			name:          "not excluded",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_vpc",
+		},
+		{
+			name:          "excluded",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_secretsmanager_secret",
+			wantNil:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName)
+
+			if testCase.wantNil {
+				if got != nil {
+					t.Errorf("got %v, want nil", got)
+				}
+			} else if got != testCase.defaultConfig {
+				t.Errorf("got %v, want %v", got, testCase.defaultConfig)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
@@ -567,6 +627,45 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key2": "value2",
 				"key3": "value3",
 			},
+		}, {
+			name: "key regexes",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/test1": "owned",
+				"kubernetes.io/cluster/test2": "shared",
+				"key1":                        "value1",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^kubernetes\.io/cluster/`),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "keys, key prefixes and key regexes",
+			tags: New(ctx, map[string]string{
+				"key1":   "value1",
+				"key2":   "value2",
+				"key3":   "value3",
+				"other1": "value4",
+				"other2": "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(ctx, []string{
+					"key1",
+				}),
+				KeyPrefixes: New(ctx, []string{
+					"key2",
+				}),
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^other\d$`),
+				},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
 		},
 	}
 
@@ -739,6 +838,79 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreRegexes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name             string
+		tags             KeyValueTags
+		ignoreTagRegexes []*regexp.Regexp
+		want             map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(ctx, map[string]string{}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexache.MustCompile(`^key`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexache.MustCompile(`^key\d$`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(ctx, map[string]string{
+				"key1":                        "value1",
+				"kubernetes.io/cluster/test1": "owned",
+				"kubernetes.io/role/elb":      "1",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexache.MustCompile(`^kubernetes\.io/cluster/[^/]+$`),
+			},
+			want: map[string]string{
+				"key1":                   "value1",
+				"kubernetes.io/role/elb": "1",
+			},
+		},
+		{
+			name: "none",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexache.MustCompile(`^other`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.IgnoreRegexes(testCase.ignoreTagRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreSystem(t *testing.T) {
 	t.Parallel()
 
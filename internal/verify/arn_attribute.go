@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// IsARNAttributeName returns whether the named argument's value is an ARN (`*_arn`) or a collection of ARNs (`*_arns`).
+func IsARNAttributeName(name string) bool {
+	return strings.HasSuffix(name, "_arn") || strings.HasSuffix(name, "_arns")
+}
+
+// ValidateARNAttribute validates the value of an ARN-valued argument against the provider configuration.
+// The ARN must be in the provider's partition.
+// Values that can't be parsed as ARNs are not validated.
+func ValidateARNAttribute(name, value, partition string) error {
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		return nil
+	}
+
+	if partition != "" && !strings.Contains(parsedARN.Partition, "*") && parsedARN.Partition != partition {
+		return fmt.Errorf("%q (%s) is an invalid ARN: partition (%s) does not match the provider's partition (%s)", name, value, parsedARN.Partition, partition)
+	}
+
+	return nil
+}
+
+// ARNServiceCheck returns an ARNCheckFunc that checks that an ARN references one of the specified service namespaces.
+// Resources opt in to the check by passing it to ValidARNCheck.
+func ARNServiceCheck(services ...string) ARNCheckFunc {
+	quoted := make([]string, len(services))
+	for i, service := range services {
+		quoted[i] = strconv.Quote(service)
+	}
+	quotedServices := strings.Join(quoted, " or ")
+
+	return func(v any, k string, parsedARN arn.ARN) (ws []string, errors []error) {
+		if !strings.Contains(parsedARN.Service, "*") && !slices.Contains(services, parsedARN.Service) {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected service %s, got %q", k, v, quotedServices, parsedARN.Service))
+		}
+
+		return ws, errors
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+)
+
+func TestValidateARNAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		value       string
+		partition   string
+		expectError bool
+	}{
+		{
+			name:      "role_arn",
+			value:     "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			partition: "aws",
+		},
+		{
+			name:        "role_arn",
+			value:       "arn:aws-us-gov:iam::123456789012:role/example", //lintignore:AWSAT005
+			partition:   "aws",
+			expectError: true,
+		},
+		{
+			name:      "policy_arn",
+			value:     "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy", //lintignore:AWSAT005
+			partition: "aws",
+		},
+		{
+			name:      "s3_bucket_arn",
+			value:     "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			partition: "aws",
+		},
+		{
+			name:      "target_arn",
+			value:     "arn:aws:sqs:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			partition: "aws",
+		},
+		{
+			name:      "source_arn",
+			value:     "arn:*:s3:::example", //lintignore:AWSAT005
+			partition: "aws-cn",
+		},
+		{
+			name:      "target_arn",
+			value:     "not-an-arn",
+			partition: "aws",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name+"/"+testCase.value, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateARNAttribute(testCase.name, testCase.value, testCase.partition)
+
+			if got, expected := err != nil, testCase.expectError; got != expected {
+				t.Errorf("got error %v, expected error: %t", err, expected)
+			}
+		})
+	}
+}
+
+func TestARNServiceCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value       string
+		services    []string
+		expectError bool
+	}{
+		{
+			value:    "arn:aws:iam::aws:policy/ReadOnlyAccess", //lintignore:AWSAT005
+			services: []string{"iam"},
+		},
+		{
+			value:       "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy", //lintignore:AWSAT005
+			services:    []string{"iam"},
+			expectError: true,
+		},
+		{
+			value:    "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			services: []string{"s3", "s3-outposts"},
+		},
+		{
+			value:    "arn:aws:*:us-west-2:123456789012:*", //lintignore:AWSAT003,AWSAT005
+			services: []string{"iam"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.value, func(t *testing.T) {
+			t.Parallel()
+
+			_, errors := ValidARNCheck(ARNServiceCheck(testCase.services...))(testCase.value, "policy_arn")
+
+			if got, expected := len(errors) > 0, testCase.expectError; got != expected {
+				t.Errorf("got errors %v, expected error: %t", errors, expected)
+			}
+		})
+	}
+}
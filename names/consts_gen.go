@@ -110,6 +110,7 @@ const (
 	Glue                         = "glue"
 	Grafana                      = "grafana"
 	Greengrass                   = "greengrass"
+	GreengrassV2                 = "greengrassv2"
 	GroundStation                = "groundstation"
 	GuardDuty                    = "guardduty"
 	HealthLake                   = "healthlake"
@@ -123,7 +124,12 @@ const (
 	InternetMonitor              = "internetmonitor"
 	IoT                          = "iot"
 	IoTAnalytics                 = "iotanalytics"
+	IoTDeviceAdvisor             = "iotdeviceadvisor"
 	IoTEvents                    = "iotevents"
+	IoTFleetWise                 = "iotfleetwise"
+	IoTSiteWise                  = "iotsitewise"
+	IoTTwinMaker                 = "iottwinmaker"
+	IoTWireless                  = "iotwireless"
 	KMS                          = "kms"
 	Kafka                        = "kafka"
 	KafkaConnect                 = "kafkaconnect"
@@ -351,6 +357,7 @@ const (
 	GlueServiceID                         = "Glue"
 	GrafanaServiceID                      = "grafana"
 	GreengrassServiceID                   = "Greengrass"
+	GreengrassV2ServiceID                 = "GreengrassV2"
 	GroundStationServiceID                = "GroundStation"
 	GuardDutyServiceID                    = "GuardDuty"
 	HealthLakeServiceID                   = "HealthLake"
@@ -364,7 +371,12 @@ const (
 	InternetMonitorServiceID              = "InternetMonitor"
 	IoTServiceID                          = "IoT"
 	IoTAnalyticsServiceID                 = "IoTAnalytics"
+	IoTDeviceAdvisorServiceID             = "IotDeviceAdvisor"
 	IoTEventsServiceID                    = "IoT Events"
+	IoTFleetWiseServiceID                 = "IoTFleetWise"
+	IoTSiteWiseServiceID                  = "IoTSiteWise"
+	IoTTwinMakerServiceID                 = "IoTTwinMaker"
+	IoTWirelessServiceID                  = "IoT Wireless"
 	KMSServiceID                          = "KMS"
 	KafkaServiceID                        = "Kafka"
 	KafkaConnectServiceID                 = "KafkaConnect"